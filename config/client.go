@@ -23,6 +23,18 @@ func NewRemoteClient(host string) (*client.Client, error) {
 	return cli, nil
 }
 
+// NewPodmanClient connects to a Podman socket, e.g.
+// "unix:///run/podman/podman.sock". Podman's REST API is Docker-API
+// compatible, so it is served by the same *client.Client used for Docker
+// hosts.
+func NewPodmanClient(host string) (*client.Client, error) {
+	cli, err := client.NewClientWithOpts(client.WithHost(host), client.WithAPIVersionNegotiation(), client.WithUserAgent("Docker-Client/dtop"))
+	if err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
+
 func NewSSHClient(host string) (*client.Client, error) {
 	helper, err := connhelper.GetConnectionHelper(host)
 	if err != nil {
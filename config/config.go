@@ -2,16 +2,115 @@ package config
 
 import (
 	"github.com/alecthomas/kong"
+	"gopkg.in/yaml.v3"
 )
 
 type Cli struct {
-	Hosts   []HostConfig `help:"List of hosts to connect to." name:"hosts" aliases:"host" default:"local" env:"DTOP_HOSTS"`
-	Version bool         `help:"Show version information." default:"false" name:"version" short:"v"`
+	Hosts     []HostConfig   `help:"List of hosts to connect to." name:"hosts" aliases:"host" default:"local" env:"DTOP_HOSTS"`
+	Version   bool           `help:"Show version information." default:"false" name:"version" short:"v"`
+	Views     ViewsConfig    `embed:"" prefix:"views-" yaml:"views"`
+	Hotkeys   []HotkeyConfig `help:"User-defined hotkeys that run a command against the selected container." name:"hotkeys" yaml:"hotkeys"`
+	Logging   LoggingConfig  `embed:"" prefix:"logging-" yaml:"logging"`
+	Sort      SortField      `help:"Column to sort the container table by on startup." enum:"name,status" default:"name" name:"sort"`
+	Container string         `help:"Container ID or name to target with --no-stream." name:"container"`
+	NoStream  bool           `help:"Fetch a single stats sample for --container and print it, instead of launching the interactive TUI." name:"no-stream"`
+}
+
+// SortField selects which column the container table is sorted by.
+type SortField string
+
+const (
+	SortByName   SortField = "name"
+	SortByStatus SortField = "status"
+)
+
+// LoggingConfig enables a background tee that persists every container's
+// stdout/stderr to disk with size-based rotation, independently of whether
+// a user has the log view open.
+type LoggingConfig struct {
+	Directory string `help:"Directory to persist rotated container logs to. Logging is disabled when empty." name:"directory" yaml:"directory"`
+	MaxSizeMB int    `help:"Rotate a container's log file once it reaches this size, in megabytes." default:"10" name:"max-size-mb" yaml:"max_size_mb"`
+	MaxFiles  int    `help:"Number of rolled log files to keep per container." default:"5" name:"max-files" yaml:"max_files"`
+	Compress  bool   `help:"Gzip rolled log files." default:"true" name:"compress" yaml:"compress"`
+}
+
+// HotkeyCommand is the command a [[hotkeys]] entry runs. It accepts either a
+// single string, run through "sh -c", or an argv-style list of strings:
+//
+//	command: "docker exec -it {{.ID}} sh"
+//	command: ["stats", "{{.ID}}"]
+type HotkeyCommand []string
+
+func (c *HotkeyCommand) UnmarshalYAML(value *yaml.Node) error {
+	var single string
+	if err := value.Decode(&single); err == nil {
+		*c = []string{"sh", "-c", single}
+		return nil
+	}
+
+	var multi []string
+	if err := value.Decode(&multi); err != nil {
+		return err
+	}
+	*c = multi
+	return nil
+}
+
+// HotkeyConfig declares a key that execs Command against the selected
+// container, with {{.ID}}, {{.Name}}, and {{.Host}} substituted from it.
+type HotkeyConfig struct {
+	Key     string        `yaml:"key"`
+	Name    string        `yaml:"name"`
+	Command HotkeyCommand `yaml:"command"`
+}
+
+// ViewsConfig holds layout customization for the various tables rendered by dtop.
+type ViewsConfig struct {
+	Containers ContainersView `yaml:"containers"`
+}
+
+// ContainersView configures the columns shown on the container list table.
+type ContainersView struct {
+	Columns    []ColumnConfig  `help:"Columns to render on the container table, in order." name:"columns" yaml:"columns"`
+	Sparklines SparklineConfig `embed:"" prefix:"sparkline-" yaml:"sparklines"`
+}
+
+// SparklineConfig toggles the per-container CPU/memory/network history
+// sparkline columns (CPU_SPARKLINE, MEMORY_SPARKLINE, NETWORK_SPARKLINE) and
+// sizes the rolling buffer of ContainerStat samples they're drawn from.
+type SparklineConfig struct {
+	CPU     bool `help:"Show a CPU history sparkline column." name:"cpu" yaml:"cpu"`
+	Memory  bool `help:"Show a memory history sparkline column." name:"memory" yaml:"memory"`
+	Network bool `help:"Show a combined network I/O history sparkline column." name:"network" yaml:"network"`
+	Window  int  `help:"Number of ticks of history kept for sparkline columns." default:"60" name:"window" yaml:"window"`
+}
+
+// ColumnConfig describes a single column on the container table.
+type ColumnConfig struct {
+	Name  string `help:"Column name, e.g. NAME, CPU, MEMORY, STATUS, NETWORK_IO, BLOCK_IO, PIDS, UPTIME, IMAGE, HOST, ID." yaml:"name"`
+	Width int    `help:"Fixed width in columns; ignored when flex is true." yaml:"width"`
+	Flex  bool   `help:"Share remaining terminal width evenly with other flex columns." yaml:"flex"`
+	Align string `help:"Text alignment: left, right, or center. Defaults to left. Ignored by the CPU/MEMORY progress-bar columns and the sparkline columns." yaml:"align"`
+}
+
+// DefaultColumns returns the built-in column layout used when the user has
+// not declared a [views.containers] section in their config.
+func DefaultColumns() []ColumnConfig {
+	return []ColumnConfig{
+		{Name: "NAME", Width: 10, Flex: true},
+		{Name: "ID", Width: 13},
+		{Name: "CPU", Width: 10, Flex: true},
+		{Name: "MEMORY", Width: 10, Flex: true},
+		{Name: "NETWORK_IO", Width: 10, Flex: true},
+		{Name: "STATUS", Width: 22, Flex: true},
+	}
 }
 
 type HostConfig struct {
-	Host   string `help:"Host address." name:"host"`
-	Dozzle string `help:"Dozzle address." name:"dozzle"`
+	Host    string   `help:"Host address." name:"host"`
+	Dozzle  string   `help:"Dozzle address." name:"dozzle"`
+	Backend string   `help:"Container runtime backend for this host." enum:"docker,podman,containerd" default:"docker" name:"backend"`
+	ExecCmd []string `help:"Command to run for the interactive exec page; defaults to [\"/bin/sh\"]." name:"exec-cmd" yaml:"exec_cmd"`
 }
 
 func (h *HostConfig) Decode(ctx *kong.DecodeContext) error {
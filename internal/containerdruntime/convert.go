@@ -0,0 +1,69 @@
+package containerdruntime
+
+import (
+	"context"
+	"strings"
+
+	"github.com/amir20/dtop/internal/docker"
+
+	containerd "github.com/containerd/containerd/v2/client"
+)
+
+// toContainer builds a docker.Container DTO from a containerd container's
+// metadata and task status. Fields Docker reports but containerd has no
+// equivalent for (health checks, memory/CPU limits) are left at their zero
+// value.
+func (c *Client) toContainer(ctx context.Context, cc containerd.Container) (*docker.Container, error) {
+	info, err := cc.Info(c.namespacedCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	name := info.Labels["io.kubernetes.container.name"]
+	if name == "" {
+		name = info.ID
+	}
+
+	container := &docker.Container{
+		ID:        shorten(info.ID),
+		Name:      name,
+		Image:     info.Image,
+		Labels:    info.Labels,
+		CreatedAt: info.CreatedAt,
+		Host:      c.host,
+		State:     "unknown",
+	}
+
+	task, err := cc.Task(c.namespacedCtx(ctx), nil)
+	if err != nil {
+		// No task means the container was created but never started.
+		container.State = "created"
+		return container, nil
+	}
+
+	status, err := task.Status(c.namespacedCtx(ctx))
+	if err != nil {
+		return container, nil
+	}
+
+	container.State = string(status.Status)
+	return container, nil
+}
+
+func shorten(id string) string {
+	const shortLen = 12
+	if len(id) > shortLen {
+		return id[:shortLen]
+	}
+	return id
+}
+
+// logFilePath mirrors the path the containerd CRI plugin writes a
+// container's combined stdout/stderr to, so StreamLogs can tail it.
+func (c *Client) logFilePath(info map[string]string, id string) string {
+	dir := info["io.kubernetes.cri.sandbox-log-directory"]
+	if dir == "" {
+		return ""
+	}
+	return strings.TrimSuffix(dir, "/") + "/" + id + ".log"
+}
@@ -0,0 +1,111 @@
+package containerdruntime
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/amir20/dtop/internal/docker"
+)
+
+// logPollInterval is how often StreamLogs checks the CRI log file for new
+// lines once it has caught up, mimicking `tail -f`.
+const logPollInterval = 500 * time.Millisecond
+
+// StreamLogs tails the CRI log file the containerd CRI plugin writes a
+// container's combined stdout/stderr to. Each line is
+// "<RFC3339Nano timestamp> <stdout|stderr> <F|P> <content>" — F/P mark
+// whether the runtime flushed a full line or a partial one, which dtop
+// doesn't distinguish between.
+func (c *Client) StreamLogs(ctx context.Context, container *docker.Container) (<-chan docker.LogEntry, error) {
+	info, err := c.infoFor(ctx, container.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := c.logFilePath(info.Labels, info.ID)
+	if path == "" {
+		return nil, fmt.Errorf("containerd: no CRI log directory for container %q", container.ID)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan docker.LogEntry)
+	go func() {
+		defer close(out)
+		defer f.Close()
+
+		reader := bufio.NewReader(f)
+		for {
+			line, err := reader.ReadString('\n')
+			if err == nil {
+				if entry, ok := parseCRILogLine(container.ID, line); ok {
+					select {
+					case <-ctx.Done():
+						return
+					case out <- entry:
+					}
+				}
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(logPollInterval):
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (c *Client) infoFor(ctx context.Context, id string) (containerInfo, error) {
+	containers, err := c.cli.Containers(c.namespacedCtx(ctx))
+	if err != nil {
+		return containerInfo{}, err
+	}
+
+	for _, cc := range containers {
+		if shorten(cc.ID()) == id {
+			info, err := cc.Info(c.namespacedCtx(ctx))
+			if err != nil {
+				return containerInfo{}, err
+			}
+			return containerInfo{ID: info.ID, Labels: info.Labels}, nil
+		}
+	}
+
+	return containerInfo{}, fmt.Errorf("containerd: container %q not found", id)
+}
+
+type containerInfo struct {
+	ID     string
+	Labels map[string]string
+}
+
+func parseCRILogLine(containerID, line string) (docker.LogEntry, bool) {
+	line = strings.TrimSuffix(line, "\n")
+	parts := strings.SplitN(line, " ", 4)
+	if len(parts) < 4 {
+		return docker.LogEntry{}, false
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return docker.LogEntry{}, false
+	}
+
+	return docker.LogEntry{
+		ContainerID: containerID,
+		Message:     parts[3],
+		Timestamp:   timestamp,
+		Stream:      parts[1],
+	}, true
+}
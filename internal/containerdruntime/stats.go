@@ -0,0 +1,123 @@
+package containerdruntime
+
+import (
+	"context"
+	"time"
+
+	"github.com/amir20/dtop/internal/docker"
+
+	v1 "github.com/containerd/cgroups/v3/cgroup1/stats"
+	containerd "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/typeurl/v2"
+)
+
+// WatchContainerStats polls every running container's task metrics every
+// pollInterval. containerd hands back cgroup metrics as a typed Any that
+// varies by cgroup version; only the cgroup1 shape is decoded today, which
+// covers the common case. A container on cgroup v2 is skipped (no stat is
+// sent for it) instead of failing the whole poll.
+func (c *Client) WatchContainerStats(ctx context.Context) (<-chan docker.ContainerStat, error) {
+	out := make(chan docker.ContainerStat)
+
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		previous := make(map[string]*v1.Metrics)
+
+		for {
+			containers, err := c.cli.Containers(c.namespacedCtx(ctx))
+			if err == nil {
+				for _, cc := range containers {
+					stat, metrics, ok := c.statFor(ctx, cc, previous[cc.ID()])
+					if !ok {
+						continue
+					}
+					previous[cc.ID()] = metrics
+
+					select {
+					case <-ctx.Done():
+						return
+					case out <- stat:
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// statFor reads the task's current cgroup1 metrics and turns them into a
+// docker.ContainerStat, using the previous poll's metrics (if any) to
+// compute the CPU delta the same way docker.Client's stats watcher does.
+func (c *Client) statFor(ctx context.Context, cc containerd.Container, previous *v1.Metrics) (docker.ContainerStat, *v1.Metrics, bool) {
+	task, err := cc.Task(c.namespacedCtx(ctx), nil)
+	if err != nil {
+		return docker.ContainerStat{}, nil, false
+	}
+
+	raw, err := task.Metrics(c.namespacedCtx(ctx))
+	if err != nil {
+		return docker.ContainerStat{}, nil, false
+	}
+
+	decoded, err := typeurl.UnmarshalAny(raw.Data)
+	if err != nil {
+		return docker.ContainerStat{}, nil, false
+	}
+
+	metrics, ok := decoded.(*v1.Metrics)
+	if !ok || metrics.CPU == nil || metrics.Memory == nil {
+		return docker.ContainerStat{}, nil, false
+	}
+
+	var rx, tx uint64
+	stat := docker.ContainerStat{
+		ID:                      shorten(cc.ID()),
+		Time:                    time.Now(),
+		CPUPercent:              cpuPercent(previous, metrics),
+		MemoryPercent:           memoryPercent(metrics),
+		MemoryUsage:             float64(metrics.Memory.Usage.Usage),
+		NetworkReceive:          rx,
+		NetworkTransmit:         tx,
+		TotalNetworkReceived:    rx,
+		TotalNetworkTransmitted: tx,
+	}
+
+	return stat, metrics, true
+}
+
+// cpuPercent mirrors docker.Client's cgroup-delta formula: (container CPU
+// delta / elapsed wall time) as a percentage of one CPU, since cgroup1
+// doesn't expose a single host-wide "system usage" counter the way
+// /proc/stat does for Docker.
+func cpuPercent(previous, current *v1.Metrics) float64 {
+	if previous == nil {
+		return 0
+	}
+
+	cpuDelta := float64(current.CPU.Usage.Total) - float64(previous.CPU.Usage.Total)
+	elapsed := float64(pollInterval.Nanoseconds())
+	if cpuDelta <= 0 || elapsed <= 0 {
+		return 0
+	}
+
+	return cpuDelta / elapsed * 100
+}
+
+func memoryPercent(metrics *v1.Metrics) float64 {
+	limit := metrics.Memory.Usage.Limit
+	if limit == 0 {
+		return 0
+	}
+	return float64(metrics.Memory.Usage.Usage) / float64(limit) * 100
+}
@@ -2,32 +2,121 @@ package docker
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/amir20/dtop/config"
 	"github.com/docker/docker/client"
 )
 
+// pingTimeout bounds the initial version-negotiation ping each host gets
+// during NewMultiClient, so one unreachable host can never hang startup.
+const pingTimeout = 2 * time.Second
+
 type Client struct {
-	hosts []Host
+	hosts    []Host
+	errs     chan error
+	statuses chan HostStatus
+
+	mu         sync.Mutex
+	hostStatus map[string]HostStatus
 }
 
 type Host struct {
 	*client.Client
 	config.HostConfig
 	Local bool
+	// Kind is the engine this host was constructed for ("docker" or
+	// "podman" — both speak the Docker-compatible REST API this package
+	// drives). Hosts on other engines, like containerd, use a different
+	// runtime.Runtime implementation entirely and never become a Host.
+	Kind string
 }
 
+// NewMultiClient builds a Client over hosts. Each host gets an initial,
+// bounded ping to seed its connectivity state, but a host that fails this
+// ping does not abort startup — it's just marked down and left for
+// WatchContainers' own backoff loop to keep retrying in the background,
+// the same way a host that drops out later is handled.
 func NewMultiClient(hosts ...Host) (*Client, error) {
-	for _, client := range hosts {
-		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(10*time.Second))
-		defer cancel()
-		_, err := client.Ping(ctx)
-		if err != nil {
-			return nil, err
-		}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("no hosts configured")
+	}
+
+	hostStatus := make(map[string]HostStatus, len(hosts))
+	for _, host := range hosts {
+		hostStatus[host.Host] = pingHost(host)
 	}
+
 	return &Client{
-		hosts: hosts,
+		hosts:      hosts,
+		errs:       make(chan error, 16),
+		statuses:   make(chan HostStatus, 16),
+		hostStatus: hostStatus,
 	}, nil
 }
+
+// pingHost performs host's initial connectivity check under pingTimeout,
+// returning its starting HostStatus.
+func pingHost(host Host) HostStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	if _, err := host.Ping(ctx); err != nil {
+		return HostStatus{Host: host.Host, Err: err, NextRetryAt: time.Now()}
+	}
+	return HostStatus{Host: host.Host}
+}
+
+// Errors returns a channel of transient errors encountered by background
+// watchers (e.g. a host hiccuping mid-reconnect) so the UI can surface them
+// without the watcher itself ever dying.
+func (d *Client) Errors() <-chan error {
+	return d.errs
+}
+
+// reportError sends err to the Errors() channel without blocking, so a slow
+// or absent reader never stalls the watcher goroutine.
+func (d *Client) reportError(err error) {
+	select {
+	case d.errs <- err:
+	default:
+	}
+}
+
+// Statuses returns a channel of per-host connectivity updates, pushed
+// whenever a host's watcher starts retrying, backs off again, or
+// reconnects. Unlike Errors(), which is a fire-and-forget toast, every
+// update here also lands in HostStatuses() so a freshly opened error page
+// can show the current state of every host, not just the next change.
+func (d *Client) Statuses() <-chan HostStatus {
+	return d.statuses
+}
+
+// HostStatuses returns the current status of every host, for seeding a
+// freshly opened error page before the next update arrives on Statuses().
+func (d *Client) HostStatuses() []HostStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	out := make([]HostStatus, 0, len(d.hostStatus))
+	for _, status := range d.hostStatus {
+		out = append(out, status)
+	}
+	return out
+}
+
+// setStatus records host's current connectivity state and pushes it to
+// Statuses() without blocking, so a slow or absent reader never stalls the
+// watcher goroutine.
+func (d *Client) setStatus(status HostStatus) {
+	d.mu.Lock()
+	d.hostStatus[status.Host] = status
+	d.mu.Unlock()
+
+	select {
+	case d.statuses <- status:
+	default:
+	}
+}
@@ -2,76 +2,171 @@ package docker
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"time"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/filters"
 )
 
+const (
+	watchInitialBackoff = 1 * time.Second
+	watchMaxBackoff     = 30 * time.Second
+)
+
+// jitter returns d plus up to 20% random variation, so hosts that dropped
+// at the same time (e.g. a shared network blip) don't all retry in
+// lockstep.
+func jitter(d time.Duration) time.Duration {
+	return d + time.Duration(rand.Int63n(int64(d)/5+1))
+}
+
+// WatchContainers pushes the current container list for every host once, then
+// a single-element update every time a container starts, stops, or dies.
+// Each host is watched independently, so one host being unreachable never
+// blocks updates from the others.
 func (d *Client) WatchContainers(ctx context.Context) (<-chan []*Container, error) {
-	containerListOptions := container.ListOptions{
-		All: true,
-	}
 	channel := make(chan []*Container)
 
-	for _, dockerClient := range d.hosts {
-		go func(host Host) {
-			list, err := host.ContainerList(ctx, containerListOptions)
-			if err != nil {
-				panic(err)
-			}
+	for _, host := range d.hosts {
+		go d.watchHostContainers(ctx, host, channel)
+	}
+
+	return channel, nil
+}
+
+func (d *Client) watchHostContainers(ctx context.Context, host Host, channel chan<- []*Container) {
+	list, ok := d.listContainersWithBackoff(ctx, host)
+	if !ok {
+		return
+	}
+
+	containers := make([]*Container, 0, len(list))
+	for _, c := range list {
+		container, err := inspectContainer(ctx, host, c.ID)
+		if err != nil {
+			d.reportError(fmt.Errorf("%s: inspect %s: %w", host.Host, c.ID, err))
+			continue
+		}
+		containers = append(containers, &container)
+	}
+
+	select {
+	case <-ctx.Done():
+		return
+	case channel <- containers:
+	}
+
+	d.watchHostEvents(ctx, host, channel)
+}
+
+// listContainersWithBackoff retries ContainerList with exponential backoff
+// (capped at watchMaxBackoff) until it succeeds or ctx is done.
+func (d *Client) listContainersWithBackoff(ctx context.Context, host Host) ([]container.Summary, bool) {
+	backoff := watchInitialBackoff
+	for {
+		list, err := host.ContainerList(ctx, container.ListOptions{All: true})
+		if err == nil {
+			d.setStatus(HostStatus{Host: host.Host})
+			return list, true
+		}
+
+		d.reportError(fmt.Errorf("%s: list containers: %w", host.Host, err))
+
+		wait := jitter(backoff)
+		d.setStatus(HostStatus{Host: host.Host, Err: err, NextRetryAt: time.Now().Add(wait)})
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-time.After(wait):
+		}
+
+		backoff = min(backoff*2, watchMaxBackoff)
+	}
+}
+
+// watchHostEvents streams container start/stop/die events for host until ctx
+// is done. A broken stream is reconnected with the `since` filter set to the
+// last event time seen so no starts/stops are missed across reconnects,
+// backing off exponentially between attempts.
+func (d *Client) watchHostEvents(ctx context.Context, host Host, channel chan<- []*Container) {
+	since := time.Now()
+	backoff := watchInitialBackoff
+
+	for ctx.Err() == nil {
+		dockerMessages, errs := host.Events(ctx, events.ListOptions{
+			Filters: filters.NewArgs(
+				filters.Arg("type", "container"),
+				filters.Arg("event", "start"),
+				filters.Arg("event", "stop"),
+				filters.Arg("event", "die"),
+			),
+			Since: since.Format(time.RFC3339Nano),
+		})
+
+		var streamErr error
+		streaming := true
+		for streaming {
+			select {
+			case <-ctx.Done():
+				return
+
+			case err, ok := <-errs:
+				if !ok {
+					streaming = false
+					continue
+				}
+				streamErr = err
+				d.reportError(fmt.Errorf("%s: event stream: %w", host.Host, err))
+				streaming = false
+
+			case message, ok := <-dockerMessages:
+				if !ok {
+					streaming = false
+					continue
+				}
 
-			go func() {
-				defer close(channel)
-				var containers = make([]*Container, 0, len(list))
-				for _, c := range list {
-					container, err := inspectContainer(ctx, host, c.ID)
-					if err != nil {
-						panic(err)
-					}
-					containers = append(containers, &container)
+				since = time.Unix(0, message.TimeNano)
+				if backoff != watchInitialBackoff {
+					backoff = watchInitialBackoff
+					d.setStatus(HostStatus{Host: host.Host})
+				}
+
+				if len(message.Actor.ID) == 0 {
+					continue
+				}
+
+				container, err := inspectContainer(ctx, host, message.Actor.ID)
+				if err != nil {
+					continue
 				}
 
 				select {
 				case <-ctx.Done():
 					return
-				case channel <- containers:
+				case channel <- []*Container{&container}:
 				}
+			}
+		}
 
-				dockerMessages, err := host.Events(ctx, events.ListOptions{Filters: filters.NewArgs(
-					filters.Arg("type", "container"),
-					filters.Arg("event", "start"),
-					filters.Arg("event", "stop"),
-					filters.Arg("event", "die"),
-				)})
-
-				for {
-					select {
-					case <-ctx.Done():
-						return
-					case err := <-err:
-						panic(err)
-
-					case message := <-dockerMessages:
-						if len(message.Actor.ID) > 0 {
-							container, err := inspectContainer(ctx, host, message.Actor.ID)
-							if err != nil {
-								continue
-							}
-
-							select {
-							case <-ctx.Done():
-								return
-							case channel <- []*Container{&container}:
-							}
-						}
-					}
-				}
-			}()
-		}(dockerClient)
-	}
+		if streamErr == nil {
+			streamErr = fmt.Errorf("%s: event stream closed", host.Host)
+		}
 
-	return channel, nil
+		wait := jitter(backoff)
+		d.setStatus(HostStatus{Host: host.Host, Err: streamErr, NextRetryAt: time.Now().Add(wait)})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		backoff = min(backoff*2, watchMaxBackoff)
+	}
 }
 
 func inspectContainer(ctx context.Context, host Host, id string) (Container, error) {
@@ -81,3 +176,14 @@ func inspectContainer(ctx context.Context, host Host, id string) (Container, err
 	}
 	return newContainerFromJSON(json, host), nil
 }
+
+// Inspect returns the full container JSON for id on hostName, for use by the
+// inspect page to show env vars, mounts, and network settings.
+func (d *Client) Inspect(ctx context.Context, hostName, id string) (container.InspectResponse, error) {
+	for _, host := range d.hosts {
+		if host.Host == hostName {
+			return host.ContainerInspect(ctx, id)
+		}
+	}
+	return container.InspectResponse{}, fmt.Errorf("host %q not found", hostName)
+}
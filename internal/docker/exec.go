@@ -0,0 +1,67 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+)
+
+// ExecSession is a live, hijacked exec connection to a running container.
+// Conn carries the multiplexed TTY stream and is also where stdin gets
+// written; ID is what ResizeExec needs to keep the remote PTY in sync with
+// the terminal.
+type ExecSession struct {
+	ID string
+	types.HijackedResponse
+}
+
+// Exec starts cmd inside id on hostName with a TTY sized width x height and
+// attaches to it, for the exec page to pipe keystrokes into and render
+// output from.
+func (d *Client) Exec(ctx context.Context, hostName, id string, cmd []string, width, height int) (*ExecSession, error) {
+	for _, host := range d.hosts {
+		if host.Host != hostName {
+			continue
+		}
+
+		created, err := host.ContainerExecCreate(ctx, id, container.ExecOptions{
+			Cmd:          cmd,
+			Tty:          true,
+			AttachStdin:  true,
+			AttachStdout: true,
+			AttachStderr: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating exec: %w", err)
+		}
+
+		attached, err := host.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{
+			Tty:         true,
+			ConsoleSize: &[2]uint{uint(height), uint(width)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("attaching exec: %w", err)
+		}
+
+		return &ExecSession{ID: created.ID, HijackedResponse: attached}, nil
+	}
+
+	return nil, fmt.Errorf("host %q not found", hostName)
+}
+
+// ResizeExec updates the TTY size of a running exec session to match the
+// terminal, keeping line-wrapping and cursor addressing correct in
+// full-screen programs run inside it.
+func (d *Client) ResizeExec(ctx context.Context, hostName, execID string, width, height int) error {
+	for _, host := range d.hosts {
+		if host.Host == hostName {
+			return host.ContainerExecResize(ctx, execID, container.ResizeOptions{
+				Height: uint(height),
+				Width:  uint(width),
+			})
+		}
+	}
+	return fmt.Errorf("host %q not found", hostName)
+}
@@ -0,0 +1,313 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// WatchContainerStats streams a ContainerStat update for every running
+// container on each host: those running at call time, plus any that start
+// afterwards. Each container's docker stats endpoint is kept open
+// (stream=true) for the lifetime of ctx.
+func (d *Client) WatchContainerStats(ctx context.Context) (<-chan ContainerStat, error) {
+	out := make(chan ContainerStat)
+
+	for _, host := range d.hosts {
+		go d.watchHostStats(ctx, host, out)
+	}
+
+	return out, nil
+}
+
+func (d *Client) watchHostStats(ctx context.Context, host Host, out chan<- ContainerStat) {
+	watching := make(map[string]bool)
+
+	list, err := host.ContainerList(ctx, container.ListOptions{})
+	if err == nil {
+		for _, c := range list {
+			watching[c.ID] = true
+			go d.watchContainerStats(ctx, host, c.ID, out)
+		}
+	}
+
+	d.watchHostStatsEvents(ctx, host, out, watching)
+}
+
+// watchHostStatsEvents starts a stats stream for every container that
+// starts after watchHostStats' initial ContainerList snapshot, since that
+// snapshot only sees what's running at subscribe time. Without this, a
+// container started later would never get a ContainerStat, leaving its
+// CPU/MEMORY/NETWORK IO columns blank for the rest of the session.
+func (d *Client) watchHostStatsEvents(ctx context.Context, host Host, out chan<- ContainerStat, watching map[string]bool) {
+	for ctx.Err() == nil {
+		dockerMessages, errs := host.Events(ctx, events.ListOptions{
+			Filters: filters.NewArgs(
+				filters.Arg("type", "container"),
+				filters.Arg("event", "start"),
+			),
+		})
+
+		streaming := true
+		for streaming {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-errs:
+				streaming = false
+
+			case message, ok := <-dockerMessages:
+				if !ok {
+					streaming = false
+					continue
+				}
+
+				id := message.Actor.ID
+				if id == "" || watching[id] {
+					continue
+				}
+				watching[id] = true
+				go d.watchContainerStats(ctx, host, id, out)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchInitialBackoff):
+		}
+	}
+}
+
+func (d *Client) watchContainerStats(ctx context.Context, host Host, id string, out chan<- ContainerStat) {
+	resp, err := host.ContainerStats(ctx, id, true)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	var previous container.StatsResponse
+	for {
+		var stats container.StatsResponse
+		if err := decoder.Decode(&stats); err != nil {
+			return
+		}
+
+		rx, tx := networkTotals(stats)
+		blkRead, blkWrite := blockIOTotals(stats)
+
+		stat := ContainerStat{
+			ID:                      id[:12],
+			Time:                    time.Now(),
+			CPUPercent:              cpuPercent(previous, stats),
+			MemoryPercent:           memoryPercent(stats),
+			MemoryUsage:             float64(stats.MemoryStats.Usage),
+			NetworkReceive:          rx,
+			NetworkTransmit:         tx,
+			TotalNetworkReceived:    rx,
+			TotalNetworkTransmitted: tx,
+			BlockRead:               blkRead,
+			BlockWrite:              blkWrite,
+			PidsCurrent:             stats.PidsStats.Current,
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case out <- stat:
+		}
+
+		previous = stats
+	}
+}
+
+// cpuPercent applies the standard delta formula used by `docker stats`:
+// (container CPU delta / host CPU delta) * online CPUs * 100.
+func cpuPercent(previous, current container.StatsResponse) float64 {
+	cpuDelta := float64(current.CPUStats.CPUUsage.TotalUsage) - float64(previous.CPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(current.CPUStats.SystemUsage) - float64(previous.CPUStats.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+
+	onlineCPUs := float64(current.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(current.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if onlineCPUs == 0 {
+		onlineCPUs = 1
+	}
+
+	return (cpuDelta / systemDelta) * onlineCPUs * 100
+}
+
+func memoryPercent(stats container.StatsResponse) float64 {
+	if stats.MemoryStats.Limit == 0 {
+		return 0
+	}
+	return float64(stats.MemoryStats.Usage) / float64(stats.MemoryStats.Limit) * 100
+}
+
+// networkTotals sums RxBytes/TxBytes across every interface reported in
+// stats, since a container's stats payload breaks usage down per interface
+// but dtop only ever shows the combined total.
+func networkTotals(stats container.StatsResponse) (rx, tx uint64) {
+	for _, n := range stats.Networks {
+		rx += n.RxBytes
+		tx += n.TxBytes
+	}
+	return rx, tx
+}
+
+// blockIOTotals sums the Read/Write entries of stats' recursive blkio
+// counters, which is the same "Read"/"Write" Op breakdown `docker stats`
+// itself totals for the BLOCK I/O column.
+func blockIOTotals(stats container.StatsResponse) (read, write uint64) {
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch entry.Op {
+		case "Read":
+			read += entry.Value
+		case "Write":
+			write += entry.Value
+		}
+	}
+	return read, write
+}
+
+// ContainerStatSample is a single point-in-time resource usage reading for
+// one container, with the network and block I/O counters already turned
+// into per-second rates using the delta against the previous sample (zero
+// on the first sample, when there is no previous one to diff against).
+type ContainerStatSample struct {
+	Timestamp      time.Time
+	CPUPercent     float64
+	MemoryUsage    float64
+	MemoryLimit    float64
+	MemoryPercent  float64
+	NetworkRxRate  float64
+	NetworkTxRate  float64
+	BlockReadRate  float64
+	BlockWriteRate float64
+}
+
+// Stats streams a ContainerStatSample for id on hostName every time the
+// docker stats endpoint emits a new reading, for the stats page to render
+// live and keep a rolling sparkline history from. The stream runs until ctx
+// is canceled.
+func (d *Client) Stats(ctx context.Context, hostName, id string) (<-chan ContainerStatSample, error) {
+	for _, host := range d.hosts {
+		if host.Host != hostName {
+			continue
+		}
+
+		resp, err := host.ContainerStats(ctx, id, true)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make(chan ContainerStatSample)
+		go streamStatSamples(ctx, resp.Body, out)
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("host %q not found", hostName)
+}
+
+// StatsOnce fetches a single, non-streaming stats reading for id on
+// hostName, mirroring the `stream` flag podman's compatible stats API
+// accepts, for one-off scripting rather than the interactive stats page.
+// CPUPercent and the rate fields are always 0, since there is no previous
+// sample to diff a single reading against.
+func (d *Client) StatsOnce(ctx context.Context, hostName, id string) (ContainerStatSample, error) {
+	for _, host := range d.hosts {
+		if host.Host != hostName {
+			continue
+		}
+
+		resp, err := host.ContainerStats(ctx, id, false)
+		if err != nil {
+			return ContainerStatSample{}, err
+		}
+		defer resp.Body.Close()
+
+		var stats container.StatsResponse
+		if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+			return ContainerStatSample{}, err
+		}
+
+		return sampleFromStats(container.StatsResponse{}, stats, time.Time{}, time.Now()), nil
+	}
+
+	return ContainerStatSample{}, fmt.Errorf("host %q not found", hostName)
+}
+
+// streamStatSamples decodes the newline-delimited stats stream in body into
+// ContainerStatSamples, closing out once body is exhausted or ctx is
+// canceled.
+func streamStatSamples(ctx context.Context, body io.ReadCloser, out chan<- ContainerStatSample) {
+	defer close(out)
+	defer body.Close()
+
+	decoder := json.NewDecoder(body)
+	var previous container.StatsResponse
+	var previousTime time.Time
+
+	for {
+		var stats container.StatsResponse
+		if err := decoder.Decode(&stats); err != nil {
+			return
+		}
+
+		now := time.Now()
+		sample := sampleFromStats(previous, stats, previousTime, now)
+
+		select {
+		case <-ctx.Done():
+			return
+		case out <- sample:
+		}
+
+		previous = stats
+		previousTime = now
+	}
+}
+
+// sampleFromStats builds a ContainerStatSample from current, using the
+// elapsed time since previousTime to turn cumulative network/block I/O
+// counters into per-second rates. previousTime is zero on the first
+// sample, which reports CPU% and every rate as 0.
+func sampleFromStats(previous, current container.StatsResponse, previousTime, now time.Time) ContainerStatSample {
+	sample := ContainerStatSample{
+		Timestamp:     now,
+		MemoryUsage:   float64(current.MemoryStats.Usage),
+		MemoryLimit:   float64(current.MemoryStats.Limit),
+		MemoryPercent: memoryPercent(current),
+	}
+
+	elapsed := now.Sub(previousTime).Seconds()
+	if previousTime.IsZero() || elapsed <= 0 {
+		return sample
+	}
+
+	sample.CPUPercent = cpuPercent(previous, current)
+
+	rx, tx := networkTotals(current)
+	prevRx, prevTx := networkTotals(previous)
+	read, write := blockIOTotals(current)
+	prevRead, prevWrite := blockIOTotals(previous)
+
+	sample.NetworkRxRate = float64(rx-prevRx) / elapsed
+	sample.NetworkTxRate = float64(tx-prevTx) / elapsed
+	sample.BlockReadRate = float64(read-prevRead) / elapsed
+	sample.BlockWriteRate = float64(write-prevWrite) / elapsed
+
+	return sample
+}
@@ -22,6 +22,7 @@ type Container struct {
 	Labels      map[string]string `json:"labels,omitempty"`
 	Dozzle      string            `json:"dozzle,omitempty"`
 	Host        string            `json:"host,omitempty"`
+	ExecCmd     []string          `json:"execCmd,omitempty"`
 }
 
 func newContainerFromJSON(c docker.InspectResponse, host Host) Container {
@@ -43,6 +44,7 @@ func newContainerFromJSON(c docker.InspectResponse, host Host) Container {
 		CPULimit:    float64(c.HostConfig.NanoCPUs) / 1e9,
 		Host:        host.Host,
 		Dozzle:      host.Dozzle,
+		ExecCmd:     host.ExecCmd,
 	}
 
 	if createdAt, err := time.Parse(time.RFC3339Nano, c.Created); err == nil {
@@ -64,6 +66,15 @@ func newContainerFromJSON(c docker.InspectResponse, host Host) Container {
 	return container
 }
 
+// LogEntry is a single line of a container's stdout/stderr, as streamed by
+// StreamLogs or replayed from an on-disk rotated log file.
+type LogEntry struct {
+	ContainerID string
+	Message     string
+	Timestamp   time.Time
+	Stream      string
+}
+
 type ContainerEvent struct {
 	Name            string            `json:"name"`
 	Host            string            `json:"host"`
@@ -73,10 +84,31 @@ type ContainerEvent struct {
 }
 
 type ContainerStat struct {
-	ID              string  `json:"id"`
-	CPUPercent      float64 `json:"cpu"`
-	MemoryPercent   float64 `json:"memory"`
-	MemoryUsage     float64 `json:"memoryUsage"`
-	NetworkReceive  uint64  `json:"networkReceive"`
-	NetworkTransmit uint64  `json:"networkTransmit"`
+	ID                      string    `json:"id"`
+	Time                    time.Time `json:"time"`
+	CPUPercent              float64   `json:"cpu"`
+	MemoryPercent           float64   `json:"memory"`
+	MemoryUsage             float64   `json:"memoryUsage"`
+	NetworkReceive          uint64    `json:"networkReceive"`
+	NetworkTransmit         uint64    `json:"networkTransmit"`
+	TotalNetworkReceived    uint64    `json:"totalNetworkReceived"`
+	TotalNetworkTransmitted uint64    `json:"totalNetworkTransmitted"`
+	BlockRead               uint64    `json:"blockRead"`
+	BlockWrite              uint64    `json:"blockWrite"`
+	PidsCurrent             uint64    `json:"pidsCurrent"`
+}
+
+// HostStatus reports the live connectivity state of a single host's
+// container watcher. Err is nil once the host is connected; while
+// reconnecting, Err holds the last failure and NextRetryAt says when the
+// next attempt fires.
+type HostStatus struct {
+	Host        string
+	Err         error
+	NextRetryAt time.Time
+}
+
+// Connected reports whether the host is currently reachable.
+func (s HostStatus) Connected() bool {
+	return s.Err == nil
 }
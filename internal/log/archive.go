@@ -0,0 +1,64 @@
+package log
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/amir20/dtop/config"
+)
+
+// ReadArchived reassembles a container's persisted log from oldest rolled
+// file to the current one, decompressing any .gz files along the way. It's
+// used to show history for a container that has stopped or been removed, so
+// `docker logs` no longer has anything to return.
+func ReadArchived(cfg config.LoggingConfig, host, name string) (string, error) {
+	if cfg.Directory == "" {
+		return "", fmt.Errorf("persistent logging is not configured")
+	}
+
+	base := filepath.Join(cfg.Directory, host, name+".log")
+
+	var chunks []string
+	for i := cfg.MaxFiles; i >= 1; i-- {
+		path := fmt.Sprintf("%s.%d", base, i)
+		if data, err := readMaybeGzip(path); err == nil {
+			chunks = append(chunks, data)
+		}
+	}
+
+	if data, err := readMaybeGzip(base); err == nil {
+		chunks = append(chunks, data)
+	} else if len(chunks) == 0 {
+		return "", err
+	}
+
+	return strings.Join(chunks, ""), nil
+}
+
+func readMaybeGzip(path string) (string, error) {
+	if data, err := os.ReadFile(path); err == nil {
+		return string(data), nil
+	}
+
+	f, err := os.Open(path + ".gz")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"context"
+
+	"github.com/amir20/dtop/config"
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+)
+
+// Capture starts a background tee for every container reported on
+// containerWatcher, persisting its stdout/stderr to disk for as long as ctx
+// is alive. It is a no-op when cfg.Directory is empty. Capture does not
+// consume the channel meant for the UI; call client.WatchContainers a
+// second time to get an independent subscription for this purpose.
+func Capture(ctx context.Context, client runtime.Runtime, containerWatcher <-chan []*docker.Container, cfg config.LoggingConfig) {
+	if cfg.Directory == "" {
+		return
+	}
+
+	go func() {
+		started := make(map[string]bool)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case batch, ok := <-containerWatcher:
+				if !ok {
+					return
+				}
+				for _, c := range batch {
+					if started[c.ID] {
+						continue
+					}
+					started[c.ID] = true
+					go captureContainer(ctx, client, c, cfg)
+				}
+			}
+		}
+	}()
+}
+
+func captureContainer(ctx context.Context, client runtime.Runtime, c *docker.Container, cfg config.LoggingConfig) {
+	writer, err := NewWriter(cfg, c.Host, c.Name)
+	if err != nil {
+		return
+	}
+	defer writer.Close()
+
+	entries, err := client.StreamLogs(ctx, c)
+	if err != nil {
+		return
+	}
+
+	for entry := range entries {
+		_ = writer.Write(entry)
+	}
+}
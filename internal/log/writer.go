@@ -0,0 +1,146 @@
+// Package log persists container stdout/stderr to disk with size-based
+// rotation, independently of whether a user has dtop's log view open, so a
+// container's history survives after it stops or is removed.
+package log
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/amir20/dtop/config"
+	"github.com/amir20/dtop/internal/docker"
+)
+
+// Writer rotates a single container's captured log lines to disk.
+type Writer struct {
+	cfg  config.LoggingConfig
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewWriter opens (creating if needed) <cfg.Directory>/<host>/<name>.log for
+// appending.
+func NewWriter(cfg config.LoggingConfig, host, name string) (*Writer, error) {
+	dir := filepath.Join(cfg.Directory, host)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	w := &Writer{cfg: cfg, path: filepath.Join(dir, name+".log")}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *Writer) open() error {
+	f, err := os.OpenFile(w.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644) //nolint:mnd
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	w.file = f
+	w.size = info.Size()
+	return nil
+}
+
+// Write appends entry, rotating the file first if it would exceed MaxSizeMB.
+func (w *Writer) Write(entry docker.LogEntry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	line := fmt.Sprintf("%s %s %s\n", entry.Timestamp.Format(time.RFC3339Nano), entry.Stream, entry.Message)
+
+	maxSize := int64(w.cfg.MaxSizeMB) * 1024 * 1024 //nolint:mnd
+	if maxSize > 0 && w.size+int64(len(line)) > maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.WriteString(line)
+	w.size += int64(n)
+	return err
+}
+
+// rotate renames the active file to <path>.1, shifting any existing rolled
+// files up by one and dropping the oldest beyond MaxFiles, then reopens the
+// active file fresh.
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	for i := w.cfg.MaxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(w.rolledPath(i), w.rolledPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := os.Rename(w.rolledPath(i)+".gz", w.rolledPath(i+1)+".gz"); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	rolled := w.rolledPath(1)
+	if err := os.Rename(w.path, rolled); err != nil {
+		return err
+	}
+
+	if w.cfg.Compress {
+		go compressFile(rolled)
+	}
+
+	_ = os.Remove(w.rolledPath(w.cfg.MaxFiles + 1))
+	_ = os.Remove(w.rolledPath(w.cfg.MaxFiles+1) + ".gz")
+
+	return w.open()
+}
+
+func (w *Writer) rolledPath(n int) string {
+	return fmt.Sprintf("%s.%d", w.path, n)
+}
+
+func compressFile(path string) {
+	in, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer in.Close()
+
+	out, err := os.Create(path + ".gz")
+	if err != nil {
+		return
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	if _, err := io.Copy(gz, in); err != nil {
+		gz.Close()
+		return
+	}
+	if err := gz.Close(); err != nil {
+		return
+	}
+
+	os.Remove(path)
+}
+
+// Close flushes and closes the active file.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
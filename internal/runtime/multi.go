@@ -0,0 +1,212 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/docker/docker/api/types/container"
+)
+
+// Multi fans WatchContainers/WatchContainerStats out across every backend
+// in hostBackends and routes StreamLogs to whichever backend owns the
+// requested container's host. It's what lets dtop watch a mixed
+// Docker/Podman/containerd fleet as a single Runtime; a single-engine fleet
+// has no need for it, since docker.Client already aggregates multiple
+// Docker/Podman hosts on its own.
+//
+// Multi also forwards the optional Errorer/Statuser/Inspector/Execer/
+// StatStreamer interfaces per-host via hostBackend, so mixing in a backend
+// that lacks one of them (e.g. containerd has no Inspector) only disables
+// it for that backend's own hosts, not for every host Multi fans in.
+type Multi struct {
+	backends    []Runtime
+	hostBackend map[string]Runtime
+
+	errsOnce sync.Once
+	errs     chan error
+
+	statusesOnce sync.Once
+	statuses     chan HostStatus
+}
+
+// NewMulti builds a Multi from a host name -> backend map. The same backend
+// may be registered under several host names (docker.Client already owns
+// more than one host internally).
+func NewMulti(hostBackends map[string]Runtime) *Multi {
+	m := &Multi{hostBackend: hostBackends}
+
+	seen := make(map[Runtime]bool, len(hostBackends))
+	for _, b := range hostBackends {
+		if !seen[b] {
+			seen[b] = true
+			m.backends = append(m.backends, b)
+		}
+	}
+
+	return m
+}
+
+func (m *Multi) WatchContainers(ctx context.Context) (<-chan []*Container, error) {
+	out := make(chan []*Container)
+	for _, b := range m.backends {
+		ch, err := b.WatchContainers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		go forward(ctx, ch, out)
+	}
+	return out, nil
+}
+
+func (m *Multi) WatchContainerStats(ctx context.Context) (<-chan Stat, error) {
+	out := make(chan Stat)
+	for _, b := range m.backends {
+		ch, err := b.WatchContainerStats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		go forward(ctx, ch, out)
+	}
+	return out, nil
+}
+
+func (m *Multi) StreamLogs(ctx context.Context, c *Container) (<-chan docker.LogEntry, error) {
+	b, ok := m.hostBackend[c.Host]
+	if !ok {
+		return nil, fmt.Errorf("no backend registered for host %q", c.Host)
+	}
+	return b.StreamLogs(ctx, c)
+}
+
+// Errors fans in Errors() from every backend that implements Errorer. It's
+// started lazily since it's only ever called once, by the UI at startup.
+func (m *Multi) Errors() <-chan error {
+	m.errsOnce.Do(func() {
+		m.errs = make(chan error, 16)
+		for _, b := range m.backends {
+			if e, ok := b.(Errorer); ok {
+				go forward(context.Background(), e.Errors(), m.errs)
+			}
+		}
+	})
+	return m.errs
+}
+
+// Statuses fans in Statuses() from every backend that implements Statuser.
+func (m *Multi) Statuses() <-chan HostStatus {
+	m.statusesOnce.Do(func() {
+		m.statuses = make(chan HostStatus, 16)
+		for _, b := range m.backends {
+			if s, ok := b.(Statuser); ok {
+				go forward(context.Background(), s.Statuses(), m.statuses)
+			}
+		}
+	})
+	return m.statuses
+}
+
+// HostStatuses concatenates HostStatuses() from every backend that
+// implements Statuser, so a backend without one (hosts always assumed
+// connected) simply contributes nothing rather than blocking the others.
+func (m *Multi) HostStatuses() []HostStatus {
+	var out []HostStatus
+	for _, b := range m.backends {
+		if s, ok := b.(Statuser); ok {
+			out = append(out, s.HostStatuses()...)
+		}
+	}
+	return out
+}
+
+// Inspect dispatches to host's backend's Inspector, if it has one.
+func (m *Multi) Inspect(ctx context.Context, host, id string) (container.InspectResponse, error) {
+	b, ok := m.hostBackend[host]
+	if !ok {
+		return container.InspectResponse{}, fmt.Errorf("no backend registered for host %q", host)
+	}
+	inspector, ok := b.(Inspector)
+	if !ok {
+		return container.InspectResponse{}, fmt.Errorf("inspect is not supported for host %q", host)
+	}
+	return inspector.Inspect(ctx, host, id)
+}
+
+// Exec dispatches to host's backend's Execer, if it has one.
+func (m *Multi) Exec(ctx context.Context, host, id string, cmd []string, width, height int) (*docker.ExecSession, error) {
+	b, ok := m.hostBackend[host]
+	if !ok {
+		return nil, fmt.Errorf("no backend registered for host %q", host)
+	}
+	execer, ok := b.(Execer)
+	if !ok {
+		return nil, fmt.Errorf("exec is not supported for host %q", host)
+	}
+	return execer.Exec(ctx, host, id, cmd, width, height)
+}
+
+// ResizeExec dispatches to host's backend's Execer, if it has one.
+func (m *Multi) ResizeExec(ctx context.Context, host, execID string, width, height int) error {
+	b, ok := m.hostBackend[host]
+	if !ok {
+		return fmt.Errorf("no backend registered for host %q", host)
+	}
+	execer, ok := b.(Execer)
+	if !ok {
+		return fmt.Errorf("exec is not supported for host %q", host)
+	}
+	return execer.ResizeExec(ctx, host, execID, width, height)
+}
+
+// Stats dispatches to host's backend's StatStreamer, if it has one.
+func (m *Multi) Stats(ctx context.Context, host, id string) (<-chan docker.ContainerStatSample, error) {
+	b, ok := m.hostBackend[host]
+	if !ok {
+		return nil, fmt.Errorf("no backend registered for host %q", host)
+	}
+	streamer, ok := b.(StatStreamer)
+	if !ok {
+		return nil, fmt.Errorf("stats are not supported for host %q", host)
+	}
+	return streamer.Stats(ctx, host, id)
+}
+
+// StatsOnce dispatches to host's backend's StatStreamer, if it has one.
+func (m *Multi) StatsOnce(ctx context.Context, host, id string) (docker.ContainerStatSample, error) {
+	b, ok := m.hostBackend[host]
+	if !ok {
+		return docker.ContainerStatSample{}, fmt.Errorf("no backend registered for host %q", host)
+	}
+	streamer, ok := b.(StatStreamer)
+	if !ok {
+		return docker.ContainerStatSample{}, fmt.Errorf("stats are not supported for host %q", host)
+	}
+	return streamer.StatsOnce(ctx, host, id)
+}
+
+// forward relays every value from in to out until in closes or ctx is done.
+func forward[T any](ctx context.Context, in <-chan T, out chan<- T) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case v, ok := <-in:
+			if !ok {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case out <- v:
+			}
+		}
+	}
+}
+
+var _ Runtime = (*Multi)(nil)
+var _ Errorer = (*Multi)(nil)
+var _ Statuser = (*Multi)(nil)
+var _ Inspector = (*Multi)(nil)
+var _ Execer = (*Multi)(nil)
+var _ StatStreamer = (*Multi)(nil)
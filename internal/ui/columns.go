@@ -0,0 +1,358 @@
+package ui
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/amir20/dtop/config"
+	"github.com/amir20/dtop/internal/ui/components/table"
+
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+	"github.com/mattn/go-runewidth"
+)
+
+// columnAlign resolves a column's configured Align ("left"/"right"/"center",
+// case-insensitive) to a lipgloss alignment, falling back to a renderer's
+// own default when Align is unset or unrecognized.
+func columnAlign(cc config.ColumnConfig, fallback lipgloss.Position) lipgloss.Position {
+	switch strings.ToLower(cc.Align) {
+	case "left":
+		return lipgloss.Left
+	case "right":
+		return lipgloss.Right
+	case "center":
+		return lipgloss.Center
+	default:
+		return fallback
+	}
+}
+
+// columnBuilder renders a named, user-configurable column. The icon column
+// (the leading running/stopped glyph) is not user-configurable and is always
+// prepended separately.
+type columnBuilder func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row]
+
+// columnBuilders maps the column names accepted in [views.containers].columns
+// to their renderer. Names are matched case-insensitively and with spaces
+// normalized to underscores, e.g. "NETWORK_IO" and "NETWORK IO" are the same.
+var columnBuilders = map[string]columnBuilder{
+	"NAME": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "NAME", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true)
+				truncated := runewidth.Truncate(r.container.Name, col.Width, "…")
+				value := highlightMatches(truncated, r.filter.matchedIndexes)
+				if r.container.Dozzle != "" {
+					value = link(value, path.Join(r.container.Dozzle, "container", r.container.ID))
+				}
+				rendered := style.Render(value)
+
+				if selected {
+					return selectedStyle.Render(rendered)
+				}
+				if r.health.down {
+					return mutedStyle.Render(rendered)
+				}
+				return rendered
+			},
+		}
+	},
+	"ID": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "ID", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true)
+				rendered := style.Render(r.container.ID)
+
+				if selected {
+					return selectedStyle.Render(rendered)
+				}
+				if r.health.down {
+					return mutedStyle.Render(rendered)
+				}
+				return rendered
+			},
+		}
+	},
+	"CPU": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "CPU", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				if r.container.State == "running" {
+					bar := progressBar
+					bar.Width = col.Width
+					if selected {
+						bar.PercentageStyle = selectedStyle
+					}
+					return bar.ViewAs(r.stats.cpuPercent)
+				}
+				return lipgloss.NewStyle().Width(col.Width).Inline(true).Render("")
+			},
+		}
+	},
+	"MEMORY": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "MEMORY", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				if r.container.State == "running" {
+					bar := progressBar
+					bar.Width = col.Width
+					if selected {
+						bar.PercentageStyle = selectedStyle
+					}
+					return bar.ViewAs(r.stats.memPercent)
+				}
+				return lipgloss.NewStyle().Width(col.Width).Inline(true).Render("")
+			},
+		}
+	},
+	"NETWORK_IO": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "NETWORK IO", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				value := lipgloss.NewStyle().Width(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true).
+					Render(
+						fmt.Sprintf("↑ %-9s ↓ %s", humanize.Bytes(r.stats.bytesSentPerSecond)+"/s", humanize.Bytes(r.stats.bytesReceivedPerSecond)+"/s"),
+					)
+				if selected {
+					return selectedStyle.Render(value)
+				}
+				if r.health.down {
+					return mutedStyle.Render(value)
+				}
+				return value
+			},
+		}
+	},
+	"BLOCK_IO": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "BLOCK IO", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				value := lipgloss.NewStyle().Width(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true).
+					Render(
+						fmt.Sprintf("R %-9s W %s", humanize.Bytes(r.stats.blockReadPerSecond)+"/s", humanize.Bytes(r.stats.blockWritePerSecond)+"/s"),
+					)
+				if selected {
+					return selectedStyle.Render(value)
+				}
+				if r.health.down {
+					return mutedStyle.Render(value)
+				}
+				return value
+			},
+		}
+	},
+	"PIDS": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "PIDS", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				style := lipgloss.NewStyle().Width(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Right)).MaxWidth(col.Width).Inline(true)
+				var text string
+				if r.container.State == "running" {
+					text = fmt.Sprintf("%d", r.stats.pids)
+				}
+				rendered := style.Render(text)
+
+				if selected {
+					return selectedStyle.Render(rendered)
+				}
+				if r.health.down {
+					return mutedStyle.Render(rendered)
+				}
+				return rendered
+			},
+		}
+	},
+	"UPTIME": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "UPTIME", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true)
+				var text string
+				if r.container.State == "running" {
+					text = humanize.RelTime(r.container.StartedAt, time.Now(), "", "")
+				} else {
+					text = "-"
+				}
+				rendered := style.Render(text)
+
+				if selected {
+					return selectedStyle.Render(rendered)
+				}
+				if r.health.down {
+					return mutedStyle.Render(rendered)
+				}
+				return rendered
+			},
+		}
+	},
+	"STATUS": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "STATUS", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true)
+				var text string
+				if r.container.State == "running" {
+					text = "Up " + humanize.RelTime(r.container.StartedAt, time.Now(), "", "")
+				} else {
+					text = "Exited " + humanize.RelTime(r.container.FinishedAt, time.Now(), "ago", "")
+				}
+				if r.health.down {
+					text = "⚠ " + text
+				}
+				rendered := style.Render(text)
+
+				if selected {
+					return selectedStyle.Render(rendered)
+				}
+				if r.health.down {
+					return mutedStyle.Render(rendered)
+				}
+				return rendered
+			},
+		}
+	},
+	"IMAGE": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "IMAGE", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true)
+				rendered := style.Render(runewidth.Truncate(r.container.Image, col.Width, "…"))
+
+				if selected {
+					return selectedStyle.Render(rendered)
+				}
+				if r.health.down {
+					return mutedStyle.Render(rendered)
+				}
+				return rendered
+			},
+		}
+	},
+	"HOST": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "HOST", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).AlignHorizontal(columnAlign(cc, lipgloss.Left)).Inline(true)
+				rendered := style.Render(runewidth.Truncate(r.container.Host, col.Width, "…"))
+
+				if selected {
+					return selectedStyle.Render(rendered)
+				}
+				if r.health.down {
+					return mutedStyle.Render(rendered)
+				}
+				return rendered
+			},
+		}
+	},
+	"CPU_SPARKLINE": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "CPU HISTORY", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				return renderSparkline(r.stats.cpuHistory, col.Width, 1)
+			},
+		}
+	},
+	"MEMORY_SPARKLINE": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "MEM HISTORY", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				return renderSparkline(r.stats.memHistory, col.Width, 1)
+			},
+		}
+	},
+	"NETWORK_SPARKLINE": func(cc config.ColumnConfig, progressBar progress.Model) table.Column[row] {
+		return table.Column[row]{
+			Title: "NET HISTORY", Width: cc.Width, Renderer: func(col table.Column[row], r row, selected bool) string {
+				return renderSparkline(r.stats.netHistory, col.Width, 0)
+			},
+		}
+	},
+}
+
+// highlightMatches wraps the runes of s at the given rune indexes in
+// filterMatchStyle, leaving the rest untouched. Indexes past len(s) (e.g.
+// because s was truncated after matching) are silently ignored.
+func highlightMatches(s string, indexes []int) string {
+	if len(indexes) == 0 {
+		return s
+	}
+
+	matched := make(map[int]bool, len(indexes))
+	for _, i := range indexes {
+		matched[i] = true
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(s) {
+		if matched[i] {
+			b.WriteString(filterMatchStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// normalizeColumnName lets config authors write either "NETWORK IO" or
+// "NETWORK_IO" interchangeably.
+func normalizeColumnName(name string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+}
+
+// buildColumns turns the user's [views.containers].columns declarations (or
+// config.DefaultColumns() when none are declared) into table columns, always
+// prepending the fixed state-icon column. Unknown column names are skipped.
+// sparklines appends the CPU/memory/network history columns the caller
+// enabled that aren't already present among cols.
+func buildColumns(cols []config.ColumnConfig, progressBar progress.Model, sparklines config.SparklineConfig) []table.Column[row] {
+	if len(cols) == 0 {
+		cols = config.DefaultColumns()
+	}
+
+	columns := make([]table.Column[row], 0, len(cols)+4)
+	columns = append(columns, table.Column[row]{
+		Title: "", Width: 1, Renderer: func(col table.Column[row], r row, selected bool) string {
+			style := lipgloss.NewStyle().Width(col.Width).AlignHorizontal(lipgloss.Right).MaxWidth(col.Width).Inline(true)
+			if r.container.State == "running" {
+				return greenStyle.Render(style.Render("▶"))
+			}
+			return redStyle.Render(style.Render("⏹"))
+		},
+	})
+
+	declared := make(map[string]bool, len(cols))
+	for _, cc := range cols {
+		name := normalizeColumnName(cc.Name)
+		declared[name] = true
+
+		builder, ok := columnBuilders[name]
+		if !ok {
+			continue
+		}
+		columns = append(columns, builder(cc, progressBar))
+	}
+
+	appendSparkline := func(name string, enabled bool) {
+		if !enabled || declared[name] {
+			return
+		}
+		columns = append(columns, columnBuilders[name](config.ColumnConfig{Name: name, Width: 12}, progressBar))
+	}
+	appendSparkline("CPU_SPARKLINE", sparklines.CPU)
+	appendSparkline("MEMORY_SPARKLINE", sparklines.Memory)
+	appendSparkline("NETWORK_SPARKLINE", sparklines.Network)
+
+	return columns
+}
+
+// flexibleColumnTitles returns the titles of the columns declared with
+// flex=true, used by WindowSizeMsg resizing to decide which columns share
+// the remaining terminal width.
+func flexibleColumnTitles(cols []config.ColumnConfig) []string {
+	if len(cols) == 0 {
+		cols = config.DefaultColumns()
+	}
+
+	titles := make([]string, 0, len(cols))
+	for _, cc := range cols {
+		builder, ok := columnBuilders[normalizeColumnName(cc.Name)]
+		if cc.Flex && ok {
+			titles = append(titles, builder(cc, progress.Model{}).Title)
+		}
+	}
+	return titles
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/amir20/dtop/internal/docker"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// dockerCmd builds a docker CLI invocation of args against host, the same
+// host addressing main.go uses to build clients: "local" (or the zero
+// value) runs docker directly, an ssh:// host runs the same invocation over
+// ssh, and any other host (a tcp:// address) is passed to docker via -H.
+func dockerCmd(host string, args ...string) *exec.Cmd {
+	switch {
+	case host == "" || host == "local":
+		return exec.Command("docker", args...) //nolint:gosec
+
+	case strings.HasPrefix(host, "ssh://"):
+		quoted := make([]string, len(args))
+		for i, arg := range args {
+			quoted[i] = shellQuote(arg)
+		}
+		remote := "docker " + strings.Join(quoted, " ")
+		return exec.Command("ssh", "-t", strings.TrimPrefix(host, "ssh://"), remote) //nolint:gosec
+
+	default:
+		return exec.Command("docker", append([]string{"-H", host}, args...)...) //nolint:gosec
+	}
+}
+
+// shellQuote wraps s in single quotes so it survives as one word once ssh
+// re-joins its trailing arguments into a single string for the remote
+// shell to parse.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// copyToClipboard copies text to the system clipboard and returns a
+// description of the result, for the caller to show as a toast the same way
+// a watcher error is surfaced.
+func copyToClipboard(text string) string {
+	if err := clipboard.WriteAll(text); err != nil {
+		return fmt.Sprintf("failed to copy to clipboard: %s", err)
+	}
+	return fmt.Sprintf("copied %q to clipboard", text)
+}
+
+// execShellFallbackMsg asks Update to retry execShell's second attempt
+// (sh), since tea.ExecProcess's callback can't itself suspend the program
+// again for another exec.
+type execShellFallbackMsg struct {
+	container *docker.Container
+}
+
+// commandNotFound reports whether err is the exit status docker exec
+// returns when the requested command doesn't exist in the container (127,
+// the standard shell "command not found" convention), as opposed to the
+// shell simply exiting non-zero on its own.
+func commandNotFound(err error) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() == 127
+}
+
+// execShell suspends the Bubble Tea program and opens an interactive shell
+// in c, preferring bash and falling back to sh for images that don't have
+// it. The two are tried as separate docker exec invocations rather than one
+// shell script, since "sh -c 'exec bash || exec sh'" can't actually reach
+// the fallback once sh itself is running.
+func execShell(c *docker.Container) tea.Cmd {
+	cmd := dockerCmd(c.Host, "exec", "-it", c.ID, "bash")
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		if commandNotFound(err) {
+			return execShellFallbackMsg{container: c}
+		}
+		return nil
+	})
+}
+
+// execShellSh is execShell's fallback attempt, run when bash isn't present.
+func execShellSh(c *docker.Container) tea.Cmd {
+	cmd := dockerCmd(c.Host, "exec", "-it", c.ID, "sh")
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return nil
+	})
+}
+
+// execRawLogs suspends the Bubble Tea program and streams c's logs through
+// the docker CLI directly, for users who want the unfiltered `docker logs
+// -f` output instead of dtop's own log page.
+func execRawLogs(c *docker.Container) tea.Cmd {
+	cmd := dockerCmd(c.Host, "logs", "-f", c.ID)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return nil
+	})
+}
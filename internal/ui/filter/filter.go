@@ -0,0 +1,28 @@
+// Package filter provides a small fuzzy-matching helper shared by pages
+// that let the user narrow a list incrementally (container list, and future
+// log/event views).
+package filter
+
+import "github.com/sahilm/fuzzy"
+
+type single string
+
+func (s single) String(int) string { return string(s) }
+func (s single) Len() int          { return 1 }
+
+// Match reports whether candidate fuzzy-matches query, using the
+// consecutive-character bonus and gap penalty scoring from sahilm/fuzzy. It
+// returns the match score (higher is better) and the rune indexes within
+// candidate that matched, for highlighting. An empty query always matches.
+func Match(query, candidate string) (ok bool, score int, matchedIndexes []int) {
+	if query == "" {
+		return true, 0, nil
+	}
+
+	results := fuzzy.FindFrom(query, single(candidate))
+	if len(results) == 0 {
+		return false, 0, nil
+	}
+
+	return true, results[0].Score, results[0].MatchedIndexes
+}
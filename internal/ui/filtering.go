@@ -0,0 +1,30 @@
+package ui
+
+import "github.com/amir20/dtop/internal/ui/filter"
+
+// rowMatches reports whether r matches query against its name, ID, image,
+// and label values. It returns the matched rune indexes within Name (for
+// highlighting) when the match came from the name itself; other fields
+// matching is enough to keep the row but has nothing to highlight.
+func rowMatches(query string, r row) (bool, []int) {
+	if query == "" {
+		return true, nil
+	}
+
+	if ok, _, indexes := filter.Match(query, r.container.Name); ok {
+		return true, indexes
+	}
+	if ok, _, _ := filter.Match(query, r.container.ID); ok {
+		return true, nil
+	}
+	if ok, _, _ := filter.Match(query, r.container.Image); ok {
+		return true, nil
+	}
+	for _, v := range r.container.Labels {
+		if ok, _, _ := filter.Match(query, v); ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
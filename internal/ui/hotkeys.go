@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"bytes"
+	"os/exec"
+	"text/template"
+
+	"github.com/amir20/dtop/config"
+	"github.com/amir20/dtop/internal/docker"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// buildHotkeys merges the user's [[hotkeys]] config entries into bindings
+// the key map can match against and the help bar can render.
+func buildHotkeys(hotkeys []config.HotkeyConfig) []HotkeyBinding {
+	bindings := make([]HotkeyBinding, 0, len(hotkeys))
+	for _, h := range hotkeys {
+		bindings = append(bindings, HotkeyBinding{
+			Binding: key.NewBinding(key.WithKeys(h.Key), key.WithHelp(h.Key, h.Name)),
+			Command: h.Command,
+		})
+	}
+	return bindings
+}
+
+// matchHotkey returns the first hotkey binding whose key matches msg.
+func matchHotkey(msg tea.KeyMsg, hotkeys []HotkeyBinding) (HotkeyBinding, bool) {
+	for _, hb := range hotkeys {
+		if key.Matches(msg, hb.Binding) {
+			return hb, true
+		}
+	}
+	return HotkeyBinding{}, false
+}
+
+// execHotkey renders {{.ID}}, {{.Name}}, and {{.Host}} against the selected
+// container in each command argument, then suspends the bubbletea program
+// to run it attached to the user's terminal.
+func execHotkey(hb HotkeyBinding, c *docker.Container) tea.Cmd {
+	if len(hb.Command) == 0 || c == nil {
+		return nil
+	}
+
+	data := struct {
+		ID   string
+		Name string
+		Host string
+	}{ID: c.ID, Name: c.Name, Host: c.Host}
+
+	args := make([]string, len(hb.Command))
+	for i, arg := range hb.Command {
+		args[i] = renderHotkeyArg(arg, data)
+	}
+
+	cmd := exec.Command(args[0], args[1:]...) //nolint:gosec
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return nil
+	})
+}
+
+func renderHotkeyArg(arg string, data any) string {
+	tmpl, err := template.New("hotkey").Parse(arg)
+	if err != nil {
+		return arg
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return arg
+	}
+	return buf.String()
+}
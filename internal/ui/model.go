@@ -4,25 +4,24 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path"
 	"time"
 
 	"github.com/amir20/dtop/config"
 	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
 	"github.com/amir20/dtop/internal/ui/components/table"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
 	teaTable "github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/dustin/go-humanize"
-	"github.com/mattn/go-runewidth"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-func NewModel(ctx context.Context, client *docker.Client, defaultSort config.SortField) model {
+func NewModel(ctx context.Context, client runtime.Runtime, defaultSort config.SortField, columns []config.ColumnConfig, hotkeys []config.HotkeyConfig, sparklines config.SparklineConfig, logging config.LoggingConfig) model {
 	containerWatcher, err := client.WatchContainers(ctx)
 	if err != nil {
 		fmt.Println("Error:", err)
@@ -38,99 +37,7 @@ func NewModel(ctx context.Context, client *docker.Client, defaultSort config.Sor
 	progressBar := progress.New(progress.WithDefaultGradient())
 
 	tbl := table.New(
-		table.WithColumns([]table.Column[row]{
-			{
-				Title: "", Width: 1, Renderer: func(col table.Column[row], r row, selected bool) string {
-					style := lipgloss.NewStyle().Width(col.Width).AlignHorizontal(lipgloss.Right).MaxWidth(col.Width).Inline(true)
-					if r.container.State == "running" {
-						return greenStyle.Render(style.Render("▶"))
-					}
-					return redStyle.Render(style.Render("⏹"))
-				},
-			},
-			{
-				Title: "NAME", Width: 10, Renderer: func(col table.Column[row], r row, selected bool) string {
-					style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).Inline(true)
-					value := r.container.Name
-					if r.container.Dozzle != "" {
-						value = link(runewidth.Truncate(value, col.Width, "…"), path.Join(r.container.Dozzle, "container", r.container.ID))
-					} else {
-						value = runewidth.Truncate(value, col.Width, "…")
-					}
-					rendered := style.Render(value)
-
-					if selected {
-						return selectedStyle.Render(rendered)
-					}
-					return rendered
-				},
-			},
-			{
-				Title: "ID", Width: 13, Renderer: func(col table.Column[row], r row, selected bool) string {
-					style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).Inline(true)
-					rendered := style.Render(r.container.ID)
-
-					if selected {
-						return selectedStyle.Render(rendered)
-					}
-					return rendered
-				},
-			},
-			{
-				Title: "CPU", Width: 10, Renderer: func(col table.Column[row], r row, selected bool) string {
-					if r.container.State == "running" {
-						bar := progressBar
-						bar.Width = col.Width
-						if selected {
-							bar.PercentageStyle = selectedStyle
-						}
-						return bar.ViewAs(r.stats.cpuPercent)
-					}
-					return lipgloss.NewStyle().Width(col.Width).Inline(true).Render("")
-				},
-			},
-			{
-				Title: "MEMORY", Width: 10, Renderer: func(col table.Column[row], r row, selected bool) string {
-					if r.container.State == "running" {
-						bar := progressBar
-						bar.Width = col.Width
-						if selected {
-							bar.PercentageStyle = selectedStyle
-						}
-						return bar.ViewAs(r.stats.memPercent)
-					}
-					return lipgloss.NewStyle().Width(col.Width).Inline(true).Render("")
-				},
-			},
-			{
-				Title: "NETWORK IO", Width: 10, Renderer: func(col table.Column[row], r row, selected bool) string {
-					value := lipgloss.NewStyle().Width(col.Width).AlignHorizontal(lipgloss.Left).Inline(true).
-						Render(
-							fmt.Sprintf("↑ %-9s ↓ %s", humanize.Bytes(r.stats.bytesSentPerSecond)+"/s", humanize.Bytes(r.stats.bytesReceivedPerSecond)+"/s"),
-						)
-					if selected {
-						value = selectedStyle.Render(value)
-					}
-					return value
-				},
-			},
-			{
-				Title: "STATUS", Width: 22, Renderer: func(col table.Column[row], r row, selected bool) string {
-					style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).Inline(true)
-					var rendered string
-					if r.container.State == "running" {
-						rendered = style.Render("Up " + humanize.RelTime(r.container.StartedAt, time.Now(), "", ""))
-					} else {
-						rendered = style.Render("Exited " + humanize.RelTime(r.container.FinishedAt, time.Now(), "ago", ""))
-					}
-
-					if selected {
-						return selectedStyle.Render(rendered)
-					}
-					return rendered
-				},
-			},
-		}),
+		table.WithColumns(buildColumns(columns, progressBar, sparklines)),
 		table.WithFocused[row](true),
 		table.WithHeight[row](15),
 	)
@@ -144,23 +51,59 @@ func NewModel(ctx context.Context, client *docker.Client, defaultSort config.Sor
 
 	if isSSHSession() {
 		defaultKeyMap.Open.SetEnabled(false)
+		defaultKeyMap.Exec.SetEnabled(false)
+		defaultKeyMap.RawLogs.SetEnabled(false)
 	}
 
+	keyMap := defaultKeyMap
+	keyMap.Hotkeys = buildHotkeys(hotkeys)
+
 	s := spinner.New()
 	s.Spinner = spinner.Points
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 
+	filterInput := textinput.New()
+	filterInput.Prompt = "/"
+
+	// Errors() is a docker.Client extra, not part of the core Runtime
+	// interface, so backends that don't support it (like containerd) just
+	// never produce a toast rather than being forced to implement it.
+	var errs <-chan error
+	if e, ok := client.(runtime.Errorer); ok {
+		errs = e.Errors()
+	}
+
+	// Statuses() is likewise a docker.Client extra; backends without it are
+	// assumed always connected, so no row is ever muted for them.
+	var statuses <-chan runtime.HostStatus
+	hostDown := make(map[string]bool)
+	if statuser, ok := client.(runtime.Statuser); ok {
+		statuses = statuser.Statuses()
+		for _, status := range statuser.HostStatuses() {
+			hostDown[status.Host] = !status.Connected()
+		}
+	}
+
 	m := model{
 		rows:             make(map[string]row),
 		table:            tbl,
 		containerWatcher: containerWatcher,
 		stats:            stats,
-		keyMap:           defaultKeyMap,
+		keyMap:           keyMap,
 		help:             help,
 		spinner:          s,
 		loading:          true,
 		sortBy:           defaultSort,
 		sortAsc:          false,
+		columns:          columns,
+		errs:             errs,
+		filterInput:      filterInput,
+		statuses:         statuses,
+		hostDown:         hostDown,
+		sparklineWindow:  sparklines.Window,
+		ctx:              ctx,
+		client:           client,
+		logging:          logging,
 	}
 
 	// Set initial column headers with sort arrow
@@ -186,11 +129,34 @@ func waitForStatsUpdate(ch <-chan docker.ContainerStat) tea.Cmd {
 	}
 }
 
+func waitForWatchError(ch <-chan error) tea.Cmd {
+	return func() tea.Msg {
+		return watchErrorMsg(<-ch)
+	}
+}
+
+func waitForHostStatus(ch <-chan runtime.HostStatus) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}
+
+// toastDuration is how long a watcher error stays visible in the status bar.
+const toastDuration = 5 * time.Second
+
+func clearToastAfter(toast string, d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return clearToastMsg{toast: toast}
+	})
+}
+
 func (m model) Init() tea.Cmd {
 	return tea.Batch(
 		tick(),
 		m.spinner.Tick,
 		waitForContainerUpdate(m.containerWatcher),
+		waitForWatchError(m.errs),
+		waitForHostStatus(m.statuses),
 		// Stats are now polled directly in tickMsg handler, not via messages
 	)
 }
@@ -0,0 +1,43 @@
+package errorpage
+
+import (
+	"context"
+
+	"github.com/amir20/dtop/internal/runtime"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// NewModel seeds the page with every host's current status, if the client
+// supports runtime.Statuser, so a host outage is already visible the
+// moment the page opens rather than only once the next update arrives.
+func NewModel(ctx context.Context, client runtime.Runtime, width, height int) Model {
+	m := Model{
+		ctx:      ctx,
+		client:   client,
+		width:    width,
+		height:   height,
+		statuses: make(map[string]runtime.HostStatus),
+	}
+
+	if statuser, ok := client.(runtime.Statuser); ok {
+		for _, status := range statuser.HostStatuses() {
+			m.statuses[status.Host] = status
+		}
+	}
+
+	return m
+}
+
+func (m Model) Init() tea.Cmd {
+	if statuser, ok := m.client.(runtime.Statuser); ok {
+		return waitForStatus(statuser.Statuses())
+	}
+	return nil
+}
+
+func waitForStatus(ch <-chan runtime.HostStatus) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}
@@ -0,0 +1,18 @@
+package errorpage
+
+import (
+	"context"
+
+	"github.com/amir20/dtop/internal/runtime"
+)
+
+// Model shows the live connectivity status of every host dtop knows about:
+// connected, or retrying with a countdown and the last error. It exists so a host
+// outage is visible and actionable instead of only flashing a toast.
+type Model struct {
+	ctx      context.Context
+	client   runtime.Runtime
+	width    int
+	height   int
+	statuses map[string]runtime.HostStatus
+}
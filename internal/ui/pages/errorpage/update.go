@@ -0,0 +1,25 @@
+package errorpage
+
+import (
+	"github.com/amir20/dtop/internal/runtime"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case runtime.HostStatus:
+		m.statuses[msg.Host] = msg
+		if statuser, ok := m.client.(runtime.Statuser); ok {
+			return m, waitForStatus(statuser.Statuses())
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
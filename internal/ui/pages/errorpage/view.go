@@ -0,0 +1,48 @@
+package errorpage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/amir20/dtop/internal/runtime"
+	"github.com/amir20/dtop/internal/ui/styles"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m Model) View() string {
+	hosts := make([]string, 0, len(m.statuses))
+	for host := range m.statuses {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	lines := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		lines = append(lines, m.renderStatusLine(m.statuses[host]))
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, "No hosts reporting status")
+	}
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Left, lipgloss.Top, lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (m Model) renderStatusLine(status runtime.HostStatus) string {
+	if status.Connected() {
+		return styles.GreenStyle.Render(fmt.Sprintf("● %s connected", status.Host))
+	}
+
+	wait := time.Until(status.NextRetryAt).Round(time.Second)
+	if wait < 0 {
+		wait = 0
+	}
+	return styles.RedStyle.Render(fmt.Sprintf("⚠ %s retrying in %s: %s", status.Host, wait, status.Err))
+}
+
+// StatusBar implements the StatusBar interface
+func (m Model) StatusBar() string {
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, styles.HelpBarStyle.Render("Press ESC/left to go back | Press q to quit"))
+}
@@ -0,0 +1,105 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// NewModel opens an interactive exec session running cmd inside container
+// and returns a Model ready to pipe keystrokes into it and render its
+// output. cmd defaults to ["/bin/sh"] when empty.
+func NewModel(ctx context.Context, client runtime.Runtime, container *docker.Container, cmd []string, width int, height int) Model {
+	if len(cmd) == 0 {
+		cmd = []string{"/bin/sh"}
+	}
+
+	newCtx, cancel := context.WithCancel(ctx)
+
+	m := Model{
+		ctx:       newCtx,
+		client:    client,
+		container: container,
+		cancel:    cancel,
+		width:     width,
+		height:    height,
+		viewport:  viewport.New(width, height),
+	}
+
+	execer, ok := client.(runtime.Execer)
+	if !ok {
+		m.err = fmt.Errorf("exec is not supported for this container's backend")
+		m.viewport.SetContent(m.err.Error())
+		return m
+	}
+
+	session, err := execer.Exec(newCtx, container.Host, container.ID, cmd, width, height)
+	if err != nil {
+		m.err = err
+		m.viewport.SetContent(fmt.Sprintf("failed to start exec session: %s", err))
+		return m
+	}
+
+	m.session = session
+	m.outputChan = readOutput(newCtx, session)
+	return m
+}
+
+// readOutput copies session's hijacked TTY output into a channel a few KB
+// at a time, so Update can treat it like any other background stream
+// (waitForOutput) instead of blocking on the raw net.Conn.
+func readOutput(ctx context.Context, session *docker.ExecSession) <-chan []byte {
+	out := make(chan []byte)
+
+	go func() {
+		defer close(out)
+		buf := make([]byte, 4096)
+		for {
+			n, err := session.Reader.Read(buf)
+			if n > 0 {
+				chunk := make([]byte, n)
+				copy(chunk, buf[:n])
+				select {
+				case out <- chunk:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func (m Model) Init() tea.Cmd {
+	if m.outputChan == nil {
+		return nil
+	}
+	return waitForOutput(m.outputChan)
+}
+
+func waitForOutput(ch <-chan []byte) tea.Cmd {
+	return func() tea.Msg {
+		chunk, ok := <-ch
+		if !ok {
+			return streamClosed{}
+		}
+		return outputMsg(chunk)
+	}
+}
+
+// Destroy implements the Destroy interface
+func (m Model) Destroy() {
+	m.cancel()
+	if m.session != nil {
+		m.session.Close()
+	}
+}
@@ -0,0 +1,35 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+
+	"github.com/charmbracelet/bubbles/viewport"
+)
+
+// Model opens an interactive shell into a single container over the
+// Docker exec API, piping keystrokes into the hijacked stdin and
+// rendering the TTY's combined stdout/stderr stream into a viewport.
+type Model struct {
+	ctx       context.Context
+	client    runtime.Runtime
+	container *docker.Container
+	cancel    context.CancelFunc
+	width     int
+	height    int
+	viewport  viewport.Model
+
+	session    *docker.ExecSession
+	outputChan <-chan []byte
+	raw        []byte
+	err        error
+}
+
+// outputMsg carries a chunk of the exec session's TTY output.
+type outputMsg []byte
+
+// streamClosed is sent once the exec session's output stream ends, which
+// happens when the shell exits or the container stops.
+type streamClosed struct{}
@@ -0,0 +1,80 @@
+package exec
+
+import (
+	"github.com/amir20/dtop/internal/runtime"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.viewport.Width = m.width
+		m.viewport.Height = m.height
+
+		if m.session != nil {
+			if execer, ok := m.client.(runtime.Execer); ok {
+				execer.ResizeExec(m.ctx, m.container.Host, m.session.ID, m.width, m.height)
+			}
+		}
+		return m, nil
+
+	case outputMsg:
+		m.raw = append(m.raw, msg...)
+		m.viewport.SetContent(string(m.raw))
+		m.viewport.GotoBottom()
+		return m, waitForOutput(m.outputChan)
+
+	case streamClosed:
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.session != nil {
+			m.session.Conn.Write(keyBytes(msg))
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// keyBytes translates a bubbletea key event back into the raw bytes a real
+// terminal would send, so the remote TTY sees the same control sequences
+// (ctrl+c as \x03, arrow keys as their CSI escapes, ...) it would from any
+// other client.
+func keyBytes(msg tea.KeyMsg) []byte {
+	switch msg.Type {
+	case tea.KeyRunes:
+		return []byte(string(msg.Runes))
+	case tea.KeyEnter:
+		return []byte("\r")
+	case tea.KeySpace:
+		return []byte(" ")
+	case tea.KeyBackspace:
+		return []byte{0x7f}
+	case tea.KeyTab:
+		return []byte("\t")
+	case tea.KeyEsc:
+		return []byte{0x1b}
+	case tea.KeyCtrlC:
+		return []byte{0x03}
+	case tea.KeyCtrlD:
+		return []byte{0x04}
+	case tea.KeyCtrlZ:
+		return []byte{0x1a}
+	case tea.KeyUp:
+		return []byte("\x1b[A")
+	case tea.KeyDown:
+		return []byte("\x1b[B")
+	case tea.KeyRight:
+		return []byte("\x1b[C")
+	case tea.KeyLeft:
+		return []byte("\x1b[D")
+	default:
+		return []byte(msg.String())
+	}
+}
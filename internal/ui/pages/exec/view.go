@@ -0,0 +1,21 @@
+package exec
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m Model) View() string {
+	return m.viewport.View()
+}
+
+// StatusBar implements the StatusBar interface
+func (m Model) StatusBar() string {
+	help := fmt.Sprintf("Shell: %s on %s | Press ESC/left to go back", m.container.Name, m.container.Host)
+	if m.err != nil {
+		help = fmt.Sprintf("error: %s | Press ESC/left to go back", m.err)
+	}
+
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, help)
+}
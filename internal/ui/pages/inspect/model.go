@@ -0,0 +1,69 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"gopkg.in/yaml.v3"
+)
+
+func NewModel(ctx context.Context, client runtime.Runtime, container *docker.Container, width int, height int) Model {
+	input := textinput.New()
+	input.Prompt = "/"
+
+	m := Model{
+		ctx:       ctx,
+		client:    client,
+		container: container,
+		width:     width,
+		height:    height,
+		viewport:  viewport.New(width, height),
+		input:     input,
+	}
+
+	m.refresh()
+	return m
+}
+
+// refresh re-fetches the container's inspect JSON and re-renders it as YAML.
+func (m *Model) refresh() {
+	if m.container == nil {
+		m.viewport.SetContent("No container selected")
+		return
+	}
+
+	inspector, ok := m.client.(runtime.Inspector)
+	if !ok {
+		m.err = fmt.Errorf("inspect is not supported for this container's backend")
+		m.viewport.SetContent(m.err.Error())
+		return
+	}
+
+	json, err := inspector.Inspect(m.ctx, m.container.Host, m.container.ID)
+	if err != nil {
+		m.err = err
+		m.viewport.SetContent(fmt.Sprintf("failed to inspect container: %s", err))
+		return
+	}
+
+	out, err := yaml.Marshal(json)
+	if err != nil {
+		m.err = err
+		m.viewport.SetContent(fmt.Sprintf("failed to render inspect output: %s", err))
+		return
+	}
+
+	m.err = nil
+	m.raw = string(out)
+	m.viewport.SetContent(m.raw)
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
@@ -0,0 +1,32 @@
+package inspect
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+)
+
+// Model renders the raw docker inspect JSON for a single container as YAML
+// in a scrollable, searchable viewport.
+type Model struct {
+	ctx       context.Context
+	client    runtime.Runtime
+	container *docker.Container
+	width     int
+	height    int
+	viewport  viewport.Model
+
+	raw string // last rendered YAML, unhighlighted
+	err error
+
+	searching   bool
+	input       textinput.Model
+	searchRegex *regexp.Regexp
+	matchCount  int
+	matchCursor int
+}
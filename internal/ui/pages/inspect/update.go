@@ -0,0 +1,111 @@
+package inspect
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/amir20/dtop/internal/ui/styles"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.viewport.Width = m.width
+		m.viewport.Height = m.height
+
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+
+		switch msg.String() {
+		case "/":
+			m.searching = true
+			m.input.SetValue("")
+			m.input.Focus()
+			return m, textinput.Blink
+
+		case "r":
+			m.refresh()
+			m.applySearch(m.input.Value())
+			return m, nil
+
+		case "n":
+			m.jumpMatch(1)
+			return m, nil
+
+		case "N":
+			m.jumpMatch(-1)
+			return m, nil
+		}
+	}
+
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m Model) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.searching = false
+		m.input.Blur()
+		return m, nil
+
+	case "enter":
+		m.searching = false
+		m.input.Blur()
+		m.applySearch(m.input.Value())
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// applySearch highlights matches of query without removing any lines, since
+// unlike the log viewer the YAML structure needs to stay intact to be
+// readable. An empty query clears the highlight.
+func (m *Model) applySearch(query string) {
+	if query == "" {
+		m.searchRegex = nil
+		m.matchCount = 0
+		m.viewport.SetContent(m.raw)
+		return
+	}
+
+	re, err := regexp.Compile("(?i)" + query)
+	if err != nil {
+		re = regexp.MustCompile("(?i)" + regexp.QuoteMeta(query))
+	}
+
+	m.searchRegex = re
+	m.matchCount = len(re.FindAllStringIndex(m.raw, -1))
+	m.matchCursor = -1
+
+	highlighted := re.ReplaceAllStringFunc(m.raw, func(s string) string {
+		return styles.SelectedStyle.Render(s)
+	})
+	m.viewport.SetContent(highlighted)
+	m.jumpMatch(1)
+}
+
+// jumpMatch moves to the next (delta=1) or previous (delta=-1) match,
+// wrapping around, and scrolls it into view.
+func (m *Model) jumpMatch(delta int) {
+	if m.searchRegex == nil || m.matchCount == 0 {
+		return
+	}
+
+	locs := m.searchRegex.FindAllStringIndex(m.raw, -1)
+	m.matchCursor = ((m.matchCursor+delta)%m.matchCount + m.matchCount) % m.matchCount
+	line := strings.Count(m.raw[:locs[m.matchCursor][0]], "\n")
+	m.viewport.SetYOffset(line)
+}
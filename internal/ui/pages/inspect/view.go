@@ -0,0 +1,27 @@
+package inspect
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m Model) View() string {
+	if m.searching {
+		return lipgloss.JoinVertical(lipgloss.Left, m.viewport.View(), m.input.View())
+	}
+	return m.viewport.View()
+}
+
+// StatusBar implements the StatusBar interface
+func (m Model) StatusBar() string {
+	help := "Press ESC/left to go back | Press q to quit | / search | r refresh | n/N next/prev match"
+
+	if m.err != nil {
+		help = fmt.Sprintf("error: %s | %s", m.err, help)
+	} else if m.searchRegex != nil {
+		help = fmt.Sprintf("%d matches | %s", m.matchCount, help)
+	}
+
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, help)
+}
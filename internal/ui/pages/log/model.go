@@ -3,20 +3,27 @@ package log
 import (
 	"context"
 
+	"github.com/amir20/dtop/config"
 	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-func NewModel(ctx context.Context, client *docker.Client, container *docker.Container, width int, height int) Model {
+func NewModel(ctx context.Context, client runtime.Runtime, container *docker.Container, width int, height int, logging config.LoggingConfig) (Model, error) {
 	newCtx, cancel := context.WithCancel(ctx)
 	logChannel, err := client.StreamLogs(newCtx, container)
 
 	if err != nil {
-		panic(err)
+		cancel()
+		return Model{}, err
 	}
 
+	input := textinput.New()
+	input.Prompt = "/"
+
 	return Model{
 		ctx:        newCtx,
 		client:     client,
@@ -26,16 +33,26 @@ func NewModel(ctx context.Context, client *docker.Client, container *docker.Cont
 		height:     height,
 		viewport:   viewport.New(width, height),
 		logChannel: logChannel,
-	}
+		logging:    logging,
+		input:      input,
+		follow:     true,
+	}, nil
 }
 
 func (m Model) Init() tea.Cmd {
 	return waitForLogs(m.logChannel)
 }
 
+// streamClosed is sent once the live log stream ends, which happens when
+// the container stops or is removed, or the page's context is canceled.
+type streamClosed struct{}
+
 func waitForLogs(ch <-chan docker.LogEntry) tea.Cmd {
 	return func() tea.Msg {
-		entry := <-ch
+		entry, ok := <-ch
+		if !ok {
+			return streamClosed{}
+		}
 		return entry
 	}
 }
@@ -2,18 +2,84 @@ package log
 
 import (
 	"context"
+	"regexp"
 
+	"github.com/amir20/dtop/config"
 	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 )
 
+// inputMode tracks what the log page's single textinput is currently being
+// used for.
+type inputMode int
+
+const (
+	modeNormal inputMode = iota
+	modeFilter
+	modeSave
+)
+
+// streamMode controls which of a container's stdout/stderr the log page
+// renders.
+type streamMode int
+
+const (
+	streamBoth streamMode = iota
+	streamStdoutOnly
+	streamStderrOnly
+)
+
+// String renders streamMode for the status bar.
+func (s streamMode) String() string {
+	switch s {
+	case streamStdoutOnly:
+		return "stdout only"
+	case streamStderrOnly:
+		return "stderr only"
+	default:
+		return "stdout+stderr"
+	}
+}
+
 type Model struct {
 	ctx        context.Context
-	client     *docker.Client
+	client     runtime.Runtime
 	width      int
 	height     int
 	container  *docker.Container
 	cancel     context.CancelFunc
 	viewport   viewport.Model
 	logChannel <-chan docker.LogEntry
+	logging    config.LoggingConfig
+
+	// entries is the raw, unfiltered stream. It is never truncated by
+	// filtering or stream hiding so turning either off restores everything
+	// without re-streaming from Docker.
+	entries []docker.LogEntry
+
+	// archived is true once the live stream has ended (the container
+	// stopped or was removed) and entries has been replaced by its
+	// persisted history, if any.
+	archived bool
+
+	// follow auto-scrolls the viewport to the newest line as entries
+	// arrive. It starts on and turns off the moment the user scrolls away
+	// from the bottom themselves, the same UX the Benchkram/bob build-log
+	// viewer uses.
+	follow bool
+	stream streamMode
+
+	mode        inputMode
+	input       textinput.Model
+	filterRegex *regexp.Regexp
+	matchCount  int
+	matchCursor int
+	// matchLines holds the rendered (post-wrap) viewport line each match
+	// starts on, since a wrapped entry's line number no longer lines up
+	// with its position in the match list.
+	matchLines []int
+	statusMsg  string
 }
@@ -2,10 +2,19 @@ package log
 
 import (
 	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/amir20/dtop/internal/docker"
+	persistlog "github.com/amir20/dtop/internal/log"
 	"github.com/amir20/dtop/internal/ui/styles"
+
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wrap"
 )
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -17,29 +26,297 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 		m.viewport.Width = m.width
 		m.viewport.Height = m.height
+		m.render()
 
 	case docker.LogEntry:
-		// Check if we're at the bottom BEFORE adding new content
-		wasAtBottom := m.viewport.AtBottom()
+		m.entries = append(m.entries, msg)
+		m.render()
 
-		if m.content.Len() > 0 {
-			m.content.WriteString("\n")
+		if m.follow {
+			m.viewport.GotoBottom()
 		}
 
-		// Format timestamp and add colored timestamp prefix
-		timestamp := msg.Timestamp.Format("15:04:05.000")
-		coloredTimestamp := styles.SelectedStyle.Render(timestamp)
-		m.content.WriteString(fmt.Sprintf("%s %s", coloredTimestamp, msg.Message))
+		return m, waitForLogs(m.logChannel)
 
-		m.viewport.SetContent(m.content.String())
+	case streamClosed:
+		m.loadArchived()
+		return m, nil
 
-		if wasAtBottom {
-			m.viewport.GotoBottom()
+	case tea.KeyMsg:
+		if m.mode == modeFilter || m.mode == modeSave {
+			return m.updateInput(msg)
 		}
 
-		return m, waitForLogs(m.logChannel)
+		switch msg.String() {
+		case "/":
+			m.mode = modeFilter
+			m.input.Prompt = "/"
+			m.input.SetValue("")
+			m.input.Focus()
+			return m, textinput.Blink
+
+		case "w":
+			m.mode = modeSave
+			m.input.Prompt = "save to: "
+			m.input.SetValue(defaultLogFileName(m.container))
+			m.input.Focus()
+			return m, textinput.Blink
+
+		case "n":
+			m.jumpMatch(1)
+			return m, nil
+
+		case "N":
+			m.jumpMatch(-1)
+			return m, nil
+
+		case "f":
+			m.follow = !m.follow
+			if m.follow {
+				m.viewport.GotoBottom()
+			}
+			return m, nil
+
+		case "end":
+			m.follow = true
+			m.viewport.GotoBottom()
+			return m, nil
+
+		case "s":
+			m.stream = (m.stream + 1) % 3
+			m.render()
+			return m, nil
+		}
 	}
 
+	wasAtBottom := m.viewport.AtBottom()
 	m.viewport, cmd = m.viewport.Update(msg)
+	if m.follow && wasAtBottom && !m.viewport.AtBottom() {
+		m.follow = false
+	}
+	return m, cmd
+}
+
+func (m Model) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.mode = modeNormal
+		m.input.Blur()
+		return m, nil
+
+	case "enter":
+		mode := m.mode
+		value := m.input.Value()
+		m.mode = modeNormal
+		m.input.Blur()
+
+		if mode == modeFilter {
+			m.setFilter(value)
+			return m, nil
+		}
+
+		if err := m.saveToFile(value); err != nil {
+			m.statusMsg = fmt.Sprintf("failed to save: %s", err)
+		} else {
+			m.statusMsg = fmt.Sprintf("saved to %s", value)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
 	return m, cmd
 }
+
+// setFilter compiles query via compileSearch. An empty query clears the
+// filter.
+func (m *Model) setFilter(query string) {
+	if query == "" {
+		m.filterRegex = nil
+		m.render()
+		return
+	}
+
+	m.filterRegex = compileSearch(query)
+	m.matchCursor = -1
+	m.render()
+	m.jumpMatch(1)
+}
+
+// compileSearch parses query as a `/regex/`-delimited pattern when it's
+// wrapped in slashes, taken verbatim (case-sensitive, the user wrote a
+// pattern); otherwise query is a plain string, matched case-insensitively
+// and falling back to a literal substring match if it doesn't parse as a
+// regex on its own (e.g. it contains an unescaped paren).
+func compileSearch(query string) *regexp.Regexp {
+	if len(query) >= 2 && strings.HasPrefix(query, "/") && strings.HasSuffix(query, "/") {
+		if re, err := regexp.Compile(query[1 : len(query)-1]); err == nil {
+			return re
+		}
+	}
+
+	re, err := regexp.Compile("(?i)" + query)
+	if err != nil {
+		re = regexp.MustCompile("(?i)" + regexp.QuoteMeta(query))
+	}
+	return re
+}
+
+// visible reports whether entry passes the active stream filter.
+func (m Model) visible(entry docker.LogEntry) bool {
+	switch m.stream {
+	case streamStdoutOnly:
+		return entry.Stream != "stderr"
+	case streamStderrOnly:
+		return entry.Stream == "stderr"
+	default:
+		return true
+	}
+}
+
+// streamStyle returns the style used to render entry's message: stderr
+// renders in red so failures stand out without reading every line.
+func streamStyle(entry docker.LogEntry) lipgloss.Style {
+	if entry.Stream == "stderr" {
+		return styles.RedStyle
+	}
+	return lipgloss.NewStyle()
+}
+
+// render rebuilds the viewport content from entries, applying the active
+// stream filter and regex filter (if any) and highlighting matches. It
+// re-scans the full entry list on every call rather than diffing against
+// the last render, which is cheap enough at dtop's log volumes and keeps
+// the match count and highlights live as entries stream in without ever
+// pausing follow-tail.
+func (m *Model) render() {
+	lines := make([]string, 0, len(m.entries))
+	matches := 0
+	matchLines := make([]int, 0)
+
+	for _, entry := range m.entries {
+		if !m.visible(entry) {
+			continue
+		}
+
+		message := entry.Message
+		matched := false
+		if m.filterRegex != nil {
+			if !m.filterRegex.MatchString(message) {
+				continue
+			}
+			matched = true
+			matches++
+			message = m.filterRegex.ReplaceAllStringFunc(message, func(s string) string {
+				return styles.SelectedStyle.Render(s)
+			})
+		}
+
+		timestamp := styles.SelectedStyle.Render(entry.Timestamp.Format("15:04:05.000"))
+		line := fmt.Sprintf("%s %s", timestamp, streamStyle(entry).Render(message))
+		if m.viewport.Width > 0 {
+			line = wrap.String(line, m.viewport.Width)
+		}
+
+		// A matching entry may itself wrap across several rendered lines;
+		// jumpMatch only needs the first one to bring the match into view.
+		if matched {
+			matchLines = append(matchLines, len(lines))
+		}
+		lines = append(lines, strings.Split(line, "\n")...)
+	}
+
+	m.matchCount = matches
+	m.matchLines = matchLines
+
+	content := strings.Join(lines, "\n")
+	m.viewport.SetContent(content)
+}
+
+// loadArchived replaces entries with the container's persisted history once
+// the live stream has ended, so a stopped or removed container's output is
+// still viewable.
+func (m *Model) loadArchived() {
+	if m.container == nil {
+		return
+	}
+
+	data, err := persistlog.ReadArchived(m.logging, m.container.Host, m.container.Name)
+	if err != nil {
+		m.statusMsg = "container is gone; no archived logs found"
+		return
+	}
+
+	m.archived = true
+	m.entries = m.entries[:0]
+	for _, line := range strings.Split(data, "\n") {
+		entry, ok := parseArchivedLine(line)
+		if !ok {
+			continue
+		}
+		m.entries = append(m.entries, entry)
+	}
+	m.filterRegex = nil
+	m.render()
+	m.statusMsg = "showing archived logs"
+}
+
+// parseArchivedLine parses a line written by internal/log.Writer
+// ("<RFC3339Nano timestamp> <stream> <message>") back into a LogEntry.
+func parseArchivedLine(line string) (docker.LogEntry, bool) {
+	parts := strings.SplitN(line, " ", 3)
+	if len(parts) != 3 {
+		return docker.LogEntry{}, false
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return docker.LogEntry{}, false
+	}
+
+	return docker.LogEntry{Message: parts[2], Timestamp: timestamp, Stream: parts[1]}, true
+}
+
+// jumpMatch moves to the next (delta=1) or previous (delta=-1) match,
+// wrapping around, and scrolls its rendered line into view.
+func (m *Model) jumpMatch(delta int) {
+	if m.filterRegex == nil || m.matchCount == 0 {
+		return
+	}
+
+	m.matchCursor = ((m.matchCursor+delta)%m.matchCount + m.matchCount) % m.matchCount
+	m.viewport.SetYOffset(m.matchLines[m.matchCursor])
+}
+
+func defaultLogFileName(c *docker.Container) string {
+	if c == nil {
+		return "dtop.log"
+	}
+	return fmt.Sprintf("%s.log", c.Name)
+}
+
+// saveToFile dumps the currently visible (stream- and filter-matched)
+// entries to path with an atomic rename so a reader never sees a
+// partially-written file.
+func (m Model) saveToFile(path string) error {
+	var b strings.Builder
+	for _, entry := range m.entries {
+		if !m.visible(entry) {
+			continue
+		}
+		if m.filterRegex != nil && !m.filterRegex.MatchString(entry.Message) {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(fmt.Sprintf("%s %s %s", entry.Timestamp.Format(time.RFC3339Nano), entry.Stream, entry.Message))
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
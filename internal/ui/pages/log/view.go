@@ -7,17 +7,47 @@ import (
 )
 
 func (m Model) View() string {
-	var content string
-	if m.container != nil && m.container.Name != "" {
-		content = fmt.Sprintf("Viewing logs for: %s\nContainer ID: %s", m.container.Name, m.container.ID)
-	} else {
-		content = "No container selected"
+	if len(m.entries) == 0 {
+		var content string
+		if m.container != nil && m.container.Name != "" {
+			content = fmt.Sprintf("Viewing logs for: %s\nContainer ID: %s", m.container.Name, m.container.ID)
+		} else {
+			content = "No container selected"
+		}
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
 	}
 
-	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+	if m.mode == modeFilter || m.mode == modeSave {
+		return lipgloss.JoinVertical(lipgloss.Left, m.viewport.View(), m.input.View())
+	}
+
+	return m.viewport.View()
 }
 
 // StatusBar implements the StatusBar interface
 func (m Model) StatusBar() string {
-	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, "Press ESC/left to go back | Press q to quit")
+	help := "Press ESC/left to go back | Press q to quit | / filter | w save | n/N next/prev match | f follow | s stream"
+
+	follow := "follow off"
+	if m.follow {
+		follow = "follow on"
+	}
+
+	status := fmt.Sprintf("%d lines | %s | %s", len(m.entries), follow, m.stream)
+
+	if m.archived {
+		status = fmt.Sprintf("[archived] %s", status)
+	}
+	if m.filterRegex != nil {
+		pos := 0
+		if m.matchCount > 0 {
+			pos = m.matchCursor + 1
+		}
+		status = fmt.Sprintf("%s | %d/%d matches (n/N to jump)", status, pos, m.matchCount)
+	}
+	if m.statusMsg != "" {
+		status = fmt.Sprintf("%s | %s", status, m.statusMsg)
+	}
+
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, fmt.Sprintf("%s | %s", status, help))
 }
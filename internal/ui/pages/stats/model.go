@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func NewModel(ctx context.Context, client runtime.Runtime, container *docker.Container, width int, height int) Model {
+	newCtx, cancel := context.WithCancel(ctx)
+
+	m := Model{
+		ctx:       newCtx,
+		client:    client,
+		container: container,
+		cancel:    cancel,
+		width:     width,
+		height:    height,
+	}
+
+	streamer, ok := client.(runtime.StatStreamer)
+	if !ok {
+		m.err = fmt.Errorf("stats are not supported for this container's backend")
+		return m
+	}
+
+	statChan, err := streamer.Stats(newCtx, container.Host, container.ID)
+	if err != nil {
+		m.err = err
+		return m
+	}
+
+	m.statChan = statChan
+	return m
+}
+
+func (m Model) Init() tea.Cmd {
+	if m.statChan == nil {
+		return nil
+	}
+	return waitForStat(m.statChan)
+}
+
+func waitForStat(ch <-chan docker.ContainerStatSample) tea.Cmd {
+	return func() tea.Msg {
+		sample, ok := <-ch
+		if !ok {
+			return streamClosed{}
+		}
+		return sample
+	}
+}
+
+// Destroy implements the Destroy interface
+func (m Model) Destroy() {
+	m.cancel()
+}
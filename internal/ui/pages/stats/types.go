@@ -0,0 +1,39 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/amir20/dtop/internal/docker"
+	"github.com/amir20/dtop/internal/runtime"
+)
+
+// historyWindow bounds how many samples the sparklines keep, about two
+// minutes of history at the docker stats endpoint's ~1s cadence.
+const historyWindow = 120
+
+// Model renders a live-updating resource usage panel for a single
+// container: current CPU/memory/network/block I/O readings plus a rolling
+// sparkline history of each.
+type Model struct {
+	ctx       context.Context
+	client    runtime.Runtime
+	container *docker.Container
+	cancel    context.CancelFunc
+	width     int
+	height    int
+
+	statChan <-chan docker.ContainerStatSample
+	latest   docker.ContainerStatSample
+	err      error
+
+	cpuHistory      []float64
+	memHistory      []float64
+	netRxHistory    []float64
+	netTxHistory    []float64
+	blkReadHistory  []float64
+	blkWriteHistory []float64
+}
+
+// streamClosed is sent once the stats stream ends, which happens when the
+// container stops or is removed.
+type streamClosed struct{}
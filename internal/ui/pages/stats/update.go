@@ -0,0 +1,41 @@
+package stats
+
+import (
+	"github.com/amir20/dtop/internal/docker"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case docker.ContainerStatSample:
+		m.latest = msg
+		m.cpuHistory = appendHistory(m.cpuHistory, msg.CPUPercent)
+		m.memHistory = appendHistory(m.memHistory, msg.MemoryPercent)
+		m.netRxHistory = appendHistory(m.netRxHistory, msg.NetworkRxRate)
+		m.netTxHistory = appendHistory(m.netTxHistory, msg.NetworkTxRate)
+		m.blkReadHistory = appendHistory(m.blkReadHistory, msg.BlockReadRate)
+		m.blkWriteHistory = appendHistory(m.blkWriteHistory, msg.BlockWriteRate)
+		return m, waitForStat(m.statChan)
+
+	case streamClosed:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// appendHistory appends v to hist, dropping from the front once it exceeds
+// historyWindow samples, so the sparklines see a bounded, rolling buffer.
+func appendHistory(hist []float64, v float64) []float64 {
+	hist = append(hist, v)
+	if len(hist) > historyWindow {
+		hist = hist[len(hist)-historyWindow:]
+	}
+	return hist
+}
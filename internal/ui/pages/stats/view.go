@@ -0,0 +1,85 @@
+package stats
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/amir20/dtop/internal/ui/styles"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
+)
+
+// sparkBlocks are the eight Unicode block levels a sparkline quantizes
+// into, from emptiest to fullest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// renderSparkline quantizes history into sparkBlocks levels scaled against
+// its own min/max, coloring each from green (low) to red (high).
+func renderSparkline(history []float64) string {
+	if len(history) == 0 {
+		return ""
+	}
+
+	lo, hi := history[0], history[0]
+	for _, v := range history {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range history {
+		level := 0
+		if hi > lo {
+			level = int((v - lo) / (hi - lo) * float64(len(sparkBlocks)-1))
+		}
+		switch {
+		case level < 0:
+			level = 0
+		case level > len(sparkBlocks)-1:
+			level = len(sparkBlocks) - 1
+		}
+
+		style := styles.GreenStyle
+		switch {
+		case level >= 6:
+			style = styles.RedStyle
+		case level >= 4:
+			style = styles.SelectedStyle
+		}
+		b.WriteString(style.Render(string(sparkBlocks[level])))
+	}
+
+	return b.String()
+}
+
+func (m Model) View() string {
+	if m.err != nil {
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.err.Error())
+	}
+
+	rows := []string{
+		fmt.Sprintf("CPU       %6.1f%%  %s", m.latest.CPUPercent, renderSparkline(m.cpuHistory)),
+		fmt.Sprintf("MEMORY    %6.1f%%  %s/%s  %s", m.latest.MemoryPercent, humanize.Bytes(uint64(m.latest.MemoryUsage)), humanize.Bytes(uint64(m.latest.MemoryLimit)), renderSparkline(m.memHistory)),
+		fmt.Sprintf("NET RX    %s/s  %s", humanize.Bytes(uint64(m.latest.NetworkRxRate)), renderSparkline(m.netRxHistory)),
+		fmt.Sprintf("NET TX    %s/s  %s", humanize.Bytes(uint64(m.latest.NetworkTxRate)), renderSparkline(m.netTxHistory)),
+		fmt.Sprintf("BLOCK R   %s/s  %s", humanize.Bytes(uint64(m.latest.BlockReadRate)), renderSparkline(m.blkReadHistory)),
+		fmt.Sprintf("BLOCK W   %s/s  %s", humanize.Bytes(uint64(m.latest.BlockWriteRate)), renderSparkline(m.blkWriteHistory)),
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(strings.Join(rows, "\n"))
+}
+
+// StatusBar implements the StatusBar interface
+func (m Model) StatusBar() string {
+	help := fmt.Sprintf("Stats: %s on %s | Press ESC/left to go back", m.container.Name, m.container.Host)
+	if m.err != nil {
+		help = fmt.Sprintf("error: %s | Press ESC/left to go back", m.err)
+	}
+
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, help)
+}
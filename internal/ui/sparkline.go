@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sparkBlocks are the eight Unicode block levels a sparkline quantizes
+// into, from emptiest to fullest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparklineStyles colorizes each block level from green (low) to red
+// (high), the same ramp used elsewhere for healthy/warning/critical state.
+var sparklineStyles = []lipgloss.Style{
+	greenStyle, greenStyle, greenStyle,
+	selectedStyle, selectedStyle,
+	redStyle, redStyle, redStyle,
+}
+
+// appendHistory appends v to hist, dropping from the front once it exceeds
+// window samples, so sparkline columns see a bounded, rolling buffer.
+func appendHistory(hist []float64, v float64, window int) []float64 {
+	hist = append(hist, v)
+	if window > 0 && len(hist) > window {
+		hist = hist[len(hist)-window:]
+	}
+	return hist
+}
+
+// renderSparkline quantizes the last width samples of history into
+// sparkBlocks levels and colorizes each. max <= 0 scales against the
+// series' own min/max (e.g. for network I/O); max > 0 clamps against a
+// fixed range (e.g. 0-1 for the CPU/memory percent fractions).
+func renderSparkline(history []float64, width int, max float64) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(history) == 0 {
+		return strings.Repeat(" ", width)
+	}
+
+	samples := history
+	if len(samples) > width {
+		samples = samples[len(samples)-width:]
+	}
+
+	lo, hi := 0.0, max
+	if max <= 0 {
+		lo, hi = samples[0], samples[0]
+		for _, v := range samples {
+			if v < lo {
+				lo = v
+			}
+			if v > hi {
+				hi = v
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range samples {
+		level := 0
+		if hi > lo {
+			level = int((v - lo) / (hi - lo) * float64(len(sparkBlocks)-1))
+		}
+		if level < 0 {
+			level = 0
+		}
+		if level > len(sparkBlocks)-1 {
+			level = len(sparkBlocks) - 1
+		}
+		b.WriteString(sparklineStyles[level].Render(string(sparkBlocks[level])))
+	}
+
+	if padding := width - len(samples); padding > 0 {
+		return strings.Repeat(" ", padding) + b.String()
+	}
+	return b.String()
+}
@@ -1,20 +1,43 @@
 package ui
 
 import (
+	"context"
 	"time"
 
 	"github.com/amir20/dtop/config"
+	"github.com/amir20/dtop/internal/runtime"
 	"github.com/amir20/dtop/internal/ui/components/table"
+	"github.com/amir20/dtop/internal/ui/pages/errorpage"
+	execpage "github.com/amir20/dtop/internal/ui/pages/exec"
+	inspectpage "github.com/amir20/dtop/internal/ui/pages/inspect"
+	logpage "github.com/amir20/dtop/internal/ui/pages/log"
+	statspage "github.com/amir20/dtop/internal/ui/pages/stats"
 
 	"github.com/amir20/dtop/internal/docker"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// page identifies which full-screen view the model is currently showing.
+// The list page is the model itself; every other page is a self-contained
+// tea.Model from internal/ui/pages that the model delegates Update/View to
+// while active.
+type page int
+
+const (
+	pageList page = iota
+	pageLog
+	pageInspect
+	pageExec
+	pageStats
+	pageError
+)
+
 type rowStats struct {
 	cpuPercent             float64
 	memPercent             float64
@@ -23,6 +46,19 @@ type rowStats struct {
 	totalBytesSent         uint64
 	bytesReceivedPerSecond uint64
 	bytesSentPerSecond     uint64
+	totalBlockRead         uint64
+	totalBlockWrite        uint64
+	blockReadPerSecond     uint64
+	blockWritePerSecond    uint64
+	pids                   uint64
+
+	// cpuHistory, memHistory, and netHistory are rolling buffers of recent
+	// samples (cpuPercent/memPercent fractions and combined network
+	// bytes/sec) feeding the CPU_SPARKLINE/MEMORY_SPARKLINE/NETWORK_SPARKLINE
+	// columns, bounded to model.sparklineWindow entries.
+	cpuHistory []float64
+	memHistory []float64
+	netHistory []float64
 }
 
 type rowCache struct {
@@ -31,10 +67,25 @@ type rowCache struct {
 	status string
 }
 
+// rowFilter holds the rune indexes in Container.Name that the active
+// filter query matched, for highlighting in the NAME column.
+type rowFilter struct {
+	matchedIndexes []int
+}
+
+// rowHealth tracks whether the row's host is currently reachable, so column
+// renderers can mute the row and show a warning glyph without needing
+// access to the model's hostDown map.
+type rowHealth struct {
+	down bool
+}
+
 type row struct {
 	container *docker.Container
 	stats     *rowStats
 	cache     *rowCache
+	filter    *rowFilter
+	health    *rowHealth
 }
 
 func newRow(container *docker.Container) row {
@@ -42,23 +93,69 @@ func newRow(container *docker.Container) row {
 		container: container,
 		stats:     &rowStats{},
 		cache:     &rowCache{},
+		filter:    &rowFilter{},
+		health:    &rowHealth{},
 	}
 }
 
 type model struct {
-	rows             map[string]row
-	table            table.Model[row]
-	spinner          spinner.Model
-	width            int
-	height           int
-	containerWatcher <-chan []*docker.Container
-	stats            <-chan docker.ContainerStat
-	keyMap           KeyMap
-	help             help.Model
-	sortBy           config.SortField
-	loading          bool
-	showAll          bool
-	sortAsc          bool
+	rows                map[string]row
+	table               table.Model[row]
+	spinner             spinner.Model
+	width               int
+	height              int
+	containerWatcher    <-chan []*docker.Container
+	stats               <-chan docker.ContainerStat
+	keyMap              KeyMap
+	help                help.Model
+	sortBy              config.SortField
+	loading             bool
+	showAll             bool
+	sortAsc             bool
+	lastRenderedSortBy  config.SortField
+	lastRenderedSortAsc bool
+	columns             []config.ColumnConfig
+	errs                <-chan error
+	toast               string
+	filtering           bool
+	filterInput         textinput.Model
+	filterQuery         string
+	statuses            <-chan runtime.HostStatus
+	hostDown            map[string]bool
+	sparklineWindow     int
+
+	// ctx and client are kept around so a page-opening key (Inspect, ViewLog,
+	// ...) can construct that page's Model on demand, the same inputs
+	// NewModel itself used.
+	ctx     context.Context
+	client  runtime.Runtime
+	logging config.LoggingConfig
+
+	page        page
+	logPage     logpage.Model
+	inspectPage inspectpage.Model
+	execPage    execpage.Model
+	statsPage   statspage.Model
+	errorPage   errorpage.Model
+}
+
+// activePage returns the tea.Model for the page currently shown, or nil
+// while on the list page (which the model itself renders).
+func (m model) activePage() tea.Model {
+	switch m.page {
+	case pageLog:
+		return m.logPage
+	case pageInspect:
+		return m.inspectPage
+	case pageExec:
+		return m.execPage
+	case pageStats:
+		return m.statsPage
+	case pageError:
+		return m.errorPage
+	default:
+		return nil
+	}
 }
 
 type tickMsg time.Time
@@ -71,13 +168,34 @@ func tick() tea.Cmd {
 
 type containers []*docker.Container
 
+// watchErrorMsg carries a transient error from a background watcher (e.g. a
+// host hiccuping mid-reconnect) to be shown as a toast in the status bar.
+type watchErrorMsg error
+
+// clearToastMsg clears the toast a fixed duration after it was shown, unless
+// a newer one has already replaced it.
+type clearToastMsg struct {
+	toast string
+}
+
 type KeyMap struct {
 	LineUp   key.Binding
 	LineDown key.Binding
 	ShowAll  key.Binding
 	Open     key.Binding
+	Filter   key.Binding
 	Quit     key.Binding
+	CopyID   key.Binding
+	CopyName key.Binding
+	Exec     key.Binding
+	RawLogs  key.Binding
+	ViewLogs key.Binding
+	Inspect  key.Binding
+	Attach   key.Binding
+	Stats    key.Binding
+	Errors   key.Binding
 	Sort     SortKeyMap
+	Hotkeys  []HotkeyBinding
 }
 
 type SortKeyMap struct {
@@ -85,24 +203,57 @@ type SortKeyMap struct {
 	Status key.Binding
 }
 
+// HotkeyBinding pairs a user-declared [[hotkeys]] entry with the command it
+// runs against the selected container.
+type HotkeyBinding struct {
+	Binding key.Binding
+	Command []string
+}
+
 func (km KeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{km.LineUp, km.LineDown, km.ShowAll, km.Open, km.Sort.Name, km.Sort.Status, km.Quit}
+	bindings := []key.Binding{km.LineUp, km.LineDown, km.ShowAll, km.Open, km.Filter, km.Sort.Name, km.Sort.Status, km.Quit}
+	for _, hb := range km.Hotkeys {
+		bindings = append(bindings, hb.Binding)
+	}
+	return bindings
 }
 
 // FullHelp implements the KeyMap interface.
 func (km KeyMap) FullHelp() [][]key.Binding {
+	hotkeys := make([]key.Binding, 0, len(km.Hotkeys))
+	for _, hb := range km.Hotkeys {
+		hotkeys = append(hotkeys, hb.Binding)
+	}
 	return [][]key.Binding{
-		{km.LineUp, km.LineDown, km.ShowAll, km.Open, km.Sort.Name, km.Sort.Status, km.Quit},
-		{},
+		{km.LineUp, km.LineDown, km.ShowAll, km.Open, km.Filter, km.Sort.Name, km.Sort.Status, km.Quit},
+		{km.CopyID, km.CopyName, km.Exec, km.RawLogs},
+		{km.ViewLogs, km.Inspect, km.Attach, km.Stats, km.Errors},
+		hotkeys,
 	}
 }
 
+// backKey navigates from any non-list page back to the container list. It
+// is not part of KeyMap since it only ever applies to the pages in
+// internal/ui/pages, which render their own "ESC/left to go back" status
+// bar line instead of using m.help.
+var backKey = key.NewBinding(key.WithKeys("esc", "left"), key.WithHelp("esc/left", "Go back"))
+
 var defaultKeyMap = KeyMap{
 	LineUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "Move up")),
 	LineDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "Move down")),
 	ShowAll:  key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "Toggle all")),
 	Open:     key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "Open Dozzle")),
+	Filter:   key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "Filter")),
 	Quit:     key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "Quit")),
+	CopyID:   key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "Copy ID")),
+	CopyName: key.NewBinding(key.WithKeys("Y"), key.WithHelp("Y", "Copy name")),
+	Exec:     key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "Exec shell")),
+	RawLogs:  key.NewBinding(key.WithKeys("L"), key.WithHelp("L", "Raw logs")),
+	ViewLogs: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "View logs")),
+	Inspect:  key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "Inspect")),
+	Attach:   key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "Attach shell")),
+	Stats:    key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "Live stats")),
+	Errors:   key.NewBinding(key.WithKeys("E"), key.WithHelp("E", "Host status")),
 	Sort: SortKeyMap{
 		Name:   key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "Sort by name")),
 		Status: key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "Sort by status")),
@@ -7,9 +7,16 @@ import (
 	"strings"
 
 	"github.com/amir20/dtop/config"
+	"github.com/amir20/dtop/internal/runtime"
 	"github.com/amir20/dtop/internal/ui/components/table"
+	"github.com/amir20/dtop/internal/ui/pages/errorpage"
+	execpage "github.com/amir20/dtop/internal/ui/pages/exec"
+	inspectpage "github.com/amir20/dtop/internal/ui/pages/inspect"
+	logpage "github.com/amir20/dtop/internal/ui/pages/log"
+	statspage "github.com/amir20/dtop/internal/ui/pages/stats"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/pkg/browser"
@@ -47,9 +54,17 @@ func (m model) updateColumnHeaders() model {
 func (m model) updateInternalRows() model {
 	rows := make([]row, 0, len(m.rows))
 	for _, r := range m.rows {
-		if m.showAll || r.container.State == "running" {
-			rows = append(rows, r)
+		if !(m.showAll || r.container.State == "running") {
+			continue
 		}
+
+		matched, nameIndexes := rowMatches(m.filterQuery, r)
+		if !matched {
+			continue
+		}
+		r.filter.matchedIndexes = nameIndexes
+
+		rows = append(rows, r)
 	}
 
 	var flipDesc = func(descSort bool) bool {
@@ -75,7 +90,48 @@ func (m model) updateInternalRows() model {
 	return m
 }
 
-var flexibleColumns = []string{"NAME", "CPU", "MEMORY", "STATUS", "NETWORK IO"}
+// setActivePage stores updated back into whichever page field m.page
+// selects. It panics on an impossible page/type combination, which would
+// mean activePage() and this fell out of sync.
+func (m model) setActivePage(updated tea.Model) model {
+	switch m.page {
+	case pageLog:
+		m.logPage = updated.(logpage.Model)
+	case pageInspect:
+		m.inspectPage = updated.(inspectpage.Model)
+	case pageExec:
+		m.execPage = updated.(execpage.Model)
+	case pageStats:
+		m.statsPage = updated.(statspage.Model)
+	case pageError:
+		m.errorPage = updated.(errorpage.Model)
+	}
+	return m
+}
+
+// updateActivePage delegates msg to the page currently on screen, handling
+// the keys that apply no matter which page that is: Quit quits from every
+// page except the exec page, which passes q and ctrl+c through to the shell,
+// and Back/Esc returns to the container list, destroying the page being left
+// if it implements Destroy.
+func (m model) updateActivePage(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.keyMap.Quit) && m.page != pageExec:
+			return m, tea.Quit
+		case key.Matches(keyMsg, backKey):
+			if d, ok := m.activePage().(Destroy); ok {
+				d.Destroy()
+			}
+			m.page = pageList
+			return m, nil
+		}
+	}
+
+	updated, cmd := m.activePage().Update(msg)
+	m = m.setActivePage(updated)
+	return m, cmd
+}
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -100,9 +156,24 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						row.stats.bytesReceivedPerSecond = uint64(alpha*float64(currentBytesReceivedPerSecond) + (1-alpha)*float64(row.stats.bytesReceivedPerSecond))
 						row.stats.bytesSentPerSecond = uint64(alpha*float64(currentBytesSentPerSecond) + (1-alpha)*float64(row.stats.bytesSentPerSecond))
 					}
+					if timeDelta > 0 && !row.stats.lastUpdate.IsZero() {
+						currentBlockReadPerSecond := (stat.BlockRead - row.stats.totalBlockRead) / timeDelta
+						currentBlockWritePerSecond := (stat.BlockWrite - row.stats.totalBlockWrite) / timeDelta
+						alpha := 0.75
+						row.stats.blockReadPerSecond = uint64(alpha*float64(currentBlockReadPerSecond) + (1-alpha)*float64(row.stats.blockReadPerSecond))
+						row.stats.blockWritePerSecond = uint64(alpha*float64(currentBlockWritePerSecond) + (1-alpha)*float64(row.stats.blockWritePerSecond))
+					}
+					row.stats.totalBlockRead = stat.BlockRead
+					row.stats.totalBlockWrite = stat.BlockWrite
+					row.stats.pids = stat.PidsCurrent
+
 					row.stats.totalBytesReceived = stat.TotalNetworkReceived
 					row.stats.totalBytesSent = stat.TotalNetworkTransmitted
 					row.stats.lastUpdate = stat.Time
+
+					row.stats.cpuHistory = appendHistory(row.stats.cpuHistory, row.stats.cpuPercent, m.sparklineWindow)
+					row.stats.memHistory = appendHistory(row.stats.memHistory, row.stats.memPercent, m.sparklineWindow)
+					row.stats.netHistory = appendHistory(row.stats.netHistory, float64(row.stats.bytesReceivedPerSecond+row.stats.bytesSentPerSecond), m.sparklineWindow)
 				}
 			default:
 				processingStats = false
@@ -115,9 +186,21 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 
+		if m.page != pageList {
+			height := msg.Height
+			if _, ok := m.activePage().(StatusBar); ok {
+				height--
+			}
+			updated, cmd := m.activePage().Update(tea.WindowSizeMsg{Width: msg.Width, Height: height})
+			m = m.setActivePage(updated)
+			return m, cmd
+		}
+
 		m.table.SetWidth(msg.Width)
 		m.table.SetHeight(msg.Height - 1)
 
+		flexibleColumns := flexibleColumnTitles(m.columns)
+
 		total := m.table.Width()
 		for _, col := range m.table.Columns() {
 			if !slices.Contains(flexibleColumns, col.Title) {
@@ -146,13 +229,71 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case containers:
 		for _, c := range msg {
 			row := newRow(c)
+			row.health.down = m.hostDown[c.Host]
 			m.rows[c.ID] = row
 		}
 		m = m.updateInternalRows()
 		m.loading = false
 		return m, waitForContainerUpdate(m.containerWatcher)
 
+	case execShellFallbackMsg:
+		return m, execShellSh(msg.container)
+
+	case watchErrorMsg:
+		m.toast = msg.Error()
+		return m, tea.Batch(waitForWatchError(m.errs), clearToastAfter(m.toast, toastDuration))
+
+	case clearToastMsg:
+		if m.toast == msg.toast {
+			m.toast = ""
+		}
+		return m, nil
+
+	case runtime.HostStatus:
+		down := !msg.Connected()
+		m.hostDown[msg.Host] = down
+		for id, r := range m.rows {
+			if r.container.Host == msg.Host {
+				r.health.down = down
+				m.rows[id] = r
+			}
+		}
+		// The error page's own re-subscription is dropped: the list's
+		// waitForHostStatus already keeps reading the channel.
+		if m.page == pageError {
+			updated, _ := m.errorPage.Update(msg)
+			m.errorPage = updated.(errorpage.Model)
+		}
+		return m, waitForHostStatus(m.statuses)
+
 	case tea.KeyMsg:
+		if m.page != pageList {
+			return m.updateActivePage(msg)
+		}
+
+		if m.filtering {
+			switch msg.String() {
+			case "esc":
+				m.filtering = false
+				m.filterQuery = ""
+				m.filterInput.SetValue("")
+				m.filterInput.Blur()
+				m = m.updateInternalRows()
+				return m, nil
+
+			case "enter":
+				m.filtering = false
+				m.filterInput.Blur()
+				return m, nil
+			}
+
+			var cmd tea.Cmd
+			m.filterInput, cmd = m.filterInput.Update(msg)
+			m.filterQuery = m.filterInput.Value()
+			m = m.updateInternalRows()
+			return m, cmd
+		}
+
 		switch {
 		case key.Matches(msg, m.keyMap.LineUp):
 			m.table.MoveUp(1)
@@ -163,9 +304,83 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keyMap.Quit):
 			return m, tea.Quit
 		case key.Matches(msg, m.keyMap.Open):
-			r := m.table.Rows()[m.table.Cursor()]
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
 			browser.OpenURL(path.Join(r.container.Dozzle, "container", r.container.ID))
 			return m, nil
+		case key.Matches(msg, m.keyMap.CopyID):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			m.toast = copyToClipboard(r.container.ID)
+			return m, clearToastAfter(m.toast, toastDuration)
+		case key.Matches(msg, m.keyMap.CopyName):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			m.toast = copyToClipboard(r.container.Name)
+			return m, clearToastAfter(m.toast, toastDuration)
+		case key.Matches(msg, m.keyMap.Exec):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			return m, execShell(r.container)
+		case key.Matches(msg, m.keyMap.RawLogs):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			return m, execRawLogs(r.container)
+		case key.Matches(msg, m.keyMap.ViewLogs):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			logPage, err := logpage.NewModel(m.ctx, m.client, r.container, m.width, m.height-1, m.logging)
+			if err != nil {
+				m.toast = err.Error()
+				return m, clearToastAfter(m.toast, toastDuration)
+			}
+			m.logPage = logPage
+			m.page = pageLog
+			return m, m.logPage.Init()
+		case key.Matches(msg, m.keyMap.Inspect):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			m.inspectPage = inspectpage.NewModel(m.ctx, m.client, r.container, m.width, m.height-1)
+			m.page = pageInspect
+			return m, m.inspectPage.Init()
+		case key.Matches(msg, m.keyMap.Attach):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			m.execPage = execpage.NewModel(m.ctx, m.client, r.container, r.container.ExecCmd, m.width, m.height-1)
+			m.page = pageExec
+			return m, m.execPage.Init()
+		case key.Matches(msg, m.keyMap.Stats):
+			r := m.table.SelectedRow()
+			if r.container == nil {
+				return m, nil
+			}
+			m.statsPage = statspage.NewModel(m.ctx, m.client, r.container, m.width, m.height-1)
+			m.page = pageStats
+			return m, m.statsPage.Init()
+		case key.Matches(msg, m.keyMap.Errors):
+			m.errorPage = errorpage.NewModel(m.ctx, m.client, m.width, m.height-1)
+			m.page = pageError
+			return m, m.errorPage.Init()
+		case key.Matches(msg, m.keyMap.Filter):
+			m.filtering = true
+			m.filterInput.Focus()
+			return m, textinput.Blink
 		case key.Matches(msg, m.keyMap.ShowAll):
 			m.showAll = !m.showAll
 			m = m.updateInternalRows()
@@ -191,6 +406,20 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m = m.updateColumnHeaders()
 			return m, nil
 		}
+
+		if hb, ok := matchHotkey(msg, m.keyMap.Hotkeys); ok {
+			rows := m.table.Rows()
+			if m.table.Cursor() >= 0 && m.table.Cursor() < len(rows) {
+				return m, execHotkey(hb, rows[m.table.Cursor()].container)
+			}
+			return m, nil
+		}
+	}
+
+	// Anything else (a page's own stream updates, input blinks, ...) belongs
+	// to the page on screen.
+	if m.page != pageList {
+		return m.updateActivePage(msg)
 	}
 
 	cmds := []tea.Cmd{}
@@ -8,7 +8,26 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
+// countHostsDown returns how many entries in hostDown are currently true.
+func countHostsDown(hostDown map[string]bool) int {
+	down := 0
+	for _, d := range hostDown {
+		if d {
+			down++
+		}
+	}
+	return down
+}
+
 func (m model) View() string {
+	if m.page != pageList {
+		content := m.activePage().View()
+		if statusBarPage, ok := m.activePage().(StatusBar); ok {
+			return lipgloss.JoinVertical(lipgloss.Left, content, statusBarPage.StatusBar())
+		}
+		return content
+	}
+
 	keymap := m.keyMap
 	rows := m.table.Rows()
 	if m.loading {
@@ -47,9 +66,28 @@ func (m model) View() string {
 			m.lastRenderedSortAsc = m.sortAsc
 		}
 
+		helpLine := helpBarStyle.Render(m.help.View(keymap))
+		if down := countHostsDown(m.hostDown); down > 0 {
+			helpLine = redStyle.Render(fmt.Sprintf("⚠ %d/%d hosts down", down, len(m.hostDown))) + "  " + helpLine
+		}
+		if m.toast != "" {
+			helpLine = redStyle.Render(m.toast) + "  " + helpLine
+		}
+		if m.filterQuery != "" && !m.filtering {
+			helpLine = fmt.Sprintf("Filter: %q (%d matches)  %s", m.filterQuery, len(rows), helpLine)
+		}
+
+		if m.filtering {
+			return lipgloss.JoinVertical(
+				lipgloss.Left, m.table.View(),
+				m.filterInput.View(),
+				lipgloss.PlaceHorizontal(m.width, lipgloss.Center, helpLine),
+			)
+		}
+
 		return lipgloss.JoinVertical(
 			lipgloss.Left, m.table.View(),
-			lipgloss.PlaceHorizontal(m.width, lipgloss.Center, helpBarStyle.Render(m.help.View(keymap))),
+			lipgloss.PlaceHorizontal(m.width, lipgloss.Center, helpLine),
 		)
 	}
 }
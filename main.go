@@ -9,12 +9,16 @@ import (
 	"strings"
 
 	"github.com/amir20/dtop/config"
+	"github.com/amir20/dtop/internal/containerdruntime"
 	"github.com/amir20/dtop/internal/docker"
+	internallog "github.com/amir20/dtop/internal/log"
+	"github.com/amir20/dtop/internal/runtime"
 	"github.com/amir20/dtop/internal/ui"
 
 	"github.com/alecthomas/kong"
 	kongyaml "github.com/alecthomas/kong-yaml"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dustin/go-humanize"
 )
 
 var (
@@ -43,7 +47,31 @@ func main() {
 	}
 
 	var hosts []docker.Host
+	containerdHosts := make(map[string]runtime.Runtime)
 	for _, hc := range cfg.Hosts {
+		if hc.Backend == "podman" {
+			cli, err := config.NewPodmanClient(hc.Host)
+			if err != nil {
+				fmt.Println("Error:", err)
+				os.Exit(1)
+			}
+			hosts = append(hosts, docker.Host{
+				Client:     cli,
+				HostConfig: hc,
+				Kind:       "podman",
+			})
+			continue
+		} else if hc.Backend == "containerd" {
+			address := strings.TrimPrefix(hc.Host, "containerd://")
+			cli, err := containerdruntime.NewClient(hc.Host, address)
+			if err != nil {
+				fmt.Println("Error:", err)
+				os.Exit(1)
+			}
+			containerdHosts[hc.Host] = cli
+			continue
+		}
+
 		if hc.Host == "local" {
 			cli, err := config.NewLocalClient()
 			if err != nil {
@@ -53,6 +81,7 @@ func main() {
 			host := docker.Host{
 				Client:     cli,
 				HostConfig: hc,
+				Kind:       "docker",
 			}
 			hosts = append(hosts, host)
 		} else if strings.HasPrefix(hc.Host, "ssh://") {
@@ -64,6 +93,7 @@ func main() {
 			host := docker.Host{
 				Client:     cli,
 				HostConfig: hc,
+				Kind:       "docker",
 			}
 			hosts = append(hosts, host)
 		} else if strings.HasPrefix(hc.Host, "tcp://") {
@@ -75,6 +105,7 @@ func main() {
 			host := docker.Host{
 				Client:     cli,
 				HostConfig: hc,
+				Kind:       "docker",
 			}
 			hosts = append(hosts, host)
 		} else {
@@ -83,15 +114,102 @@ func main() {
 		}
 	}
 
-	client, err := docker.NewMultiClient(hosts...)
+	dockerClient, err := docker.NewMultiClient(hosts...)
 	if err != nil {
 		fmt.Println("Error while creating docker client:", err)
 		os.Exit(1)
 	}
 
-	p := tea.NewProgram(ui.NewModel(context.Background(), client, cfg.Sort), tea.WithAltScreen())
+	// Only reach for runtime.Multi when there's a containerd host to fan
+	// in; the common, non-mixed case keeps talking to dockerClient
+	// directly, without the extra fan-in layer.
+	var client runtime.Runtime = dockerClient
+	if len(containerdHosts) > 0 {
+		hostBackends := make(map[string]runtime.Runtime, len(hosts)+len(containerdHosts))
+		for _, h := range hosts {
+			hostBackends[h.Host] = dockerClient
+		}
+		for name, cli := range containerdHosts {
+			hostBackends[name] = cli
+		}
+		client = runtime.NewMulti(hostBackends)
+	}
+
+	ctx := context.Background()
+
+	if cfg.NoStream {
+		if err := printStatsOnce(ctx, client, cfg.Container); err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if cfg.Logging.Directory != "" {
+		captureWatcher, err := client.WatchContainers(ctx)
+		if err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+		internallog.Capture(ctx, client, captureWatcher, cfg.Logging)
+	}
+
+	p := tea.NewProgram(ui.NewModel(ctx, client, cfg.Sort, cfg.Views.Containers.Columns, cfg.Hotkeys, cfg.Views.Containers.Sparklines, cfg.Logging), tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Println("Error:", err)
 		os.Exit(1)
 	}
 }
+
+// printStatsOnce fetches a single stats sample for target (a container ID,
+// ID prefix, or name) and prints it to stdout, mirroring podman's "stats
+// --no-stream" for scripting use cases that don't want the interactive TUI.
+// target is matched against the first container list WatchContainers
+// delivers, which is a single host's list when several hosts are
+// configured; containers on the other hosts are not searched.
+func printStatsOnce(ctx context.Context, client runtime.Runtime, target string) error {
+	if target == "" {
+		return fmt.Errorf("--container is required with --no-stream")
+	}
+
+	watcher, err := client.WatchContainers(ctx)
+	if err != nil {
+		return err
+	}
+
+	list, ok := <-watcher
+	if !ok {
+		return fmt.Errorf("no containers reported")
+	}
+
+	var found *docker.Container
+	for _, c := range list {
+		if c.ID == target || c.Name == target || strings.HasPrefix(c.ID, target) {
+			found = c
+			break
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("container %q not found", target)
+	}
+
+	streamer, ok := client.(runtime.StatStreamer)
+	if !ok {
+		return fmt.Errorf("stats are not supported for this container's backend")
+	}
+
+	sample, err := streamer.StatsOnce(ctx, found.Host, found.ID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"CPU: %.1f%%  MEM: %.1f%% (%s/%s)  NET RX: %s/s  NET TX: %s/s  BLOCK R: %s/s  BLOCK W: %s/s\n",
+		sample.CPUPercent, sample.MemoryPercent,
+		humanize.Bytes(uint64(sample.MemoryUsage)), humanize.Bytes(uint64(sample.MemoryLimit)),
+		humanize.Bytes(uint64(sample.NetworkRxRate)), humanize.Bytes(uint64(sample.NetworkTxRate)),
+		humanize.Bytes(uint64(sample.BlockReadRate)), humanize.Bytes(uint64(sample.BlockWriteRate)),
+	)
+
+	return nil
+}